@@ -9,6 +9,7 @@ require (
 	fyne.io/x/fyne v0.0.0-20240803204126-8b5b5bfe65ef
 	github.com/apache/arrow-go/v18 v18.0.0
 	github.com/magpierre/go_delta_sharing_client v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
 
 )
 
@@ -63,5 +64,4 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241104194629-dd2ea8efbc28 // indirect
 	google.golang.org/grpc v1.68.0 // indirect
 	google.golang.org/protobuf v1.35.1 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
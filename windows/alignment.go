@@ -0,0 +1,60 @@
+package windows
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// alignment is how the cells of col are aligned: as chosen for the column,
+// or by type with numbers, dates and timestamps right, booleans centered
+// and everything else left.
+func (d *Data) alignment(col int) fyne.TextAlign {
+	if a, ok := d.alignments[col]; ok {
+		return a
+	}
+	if col >= len(d.fields) {
+		return fyne.TextAlignLeading
+	}
+	switch typeCategory(d.fields[col].Type) {
+	case "integer", "float", "decimal", "date", "timestamp":
+		return fyne.TextAlignTrailing
+	case "boolean":
+		return fyne.TextAlignCenter
+	}
+	return fyne.TextAlignLeading
+}
+
+// alignmentMenuItems let the user override the alignment of a column.
+func (t *DataBrowser) alignmentMenuItems(dataItem *Data, col int) []*fyne.MenuItem {
+	set := func(a fyne.TextAlign, automatic bool) func() {
+		return func() {
+			t.mu.Lock()
+			if automatic {
+				delete(dataItem.alignments, col)
+			} else {
+				if dataItem.alignments == nil {
+					dataItem.alignments = make(map[int]fyne.TextAlign)
+				}
+				dataItem.alignments[col] = a
+			}
+			t.mu.Unlock()
+			for table, d := range t.tables {
+				if d == dataItem {
+					table.Refresh()
+				}
+			}
+		}
+	}
+	_, custom := dataItem.alignments[col]
+	auto := fyne.NewMenuItem("Align by Type", set(0, true))
+	auto.Checked = !custom
+	items := []*fyne.MenuItem{fyne.NewMenuItemSeparator(), auto}
+	for _, a := range []struct {
+		label string
+		align fyne.TextAlign
+	}{{"Align Left", fyne.TextAlignLeading}, {"Align Center", fyne.TextAlignCenter}, {"Align Right", fyne.TextAlignTrailing}} {
+		item := fyne.NewMenuItem(a.label, set(a.align, false))
+		item.Checked = custom && dataItem.alignments[col] == a.align
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,60 @@
+package windows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// tokenCheckTimeout bounds the request made to tell whether a failed call
+// was rejected for its token.
+const tokenCheckTimeout = 10 * time.Second
+
+// authError is the answer 401 Unauthorized or 403 Forbidden from a sharing
+// server.
+type authError struct {
+	status int
+}
+
+func (e *authError) Error() string {
+	return fmt.Sprintf("the sharing server rejected the bearer token: %d %s", e.status, http.StatusText(e.status))
+}
+
+// checkToken lists the shares of the profile's endpoint with its bearer
+// token and returns an *authError when the server rejects it. The sharing
+// client drops the status code of the calls it makes, so failed calls are
+// checked this way. Other failures are left to the call that reported them.
+func checkToken(ctx context.Context, profile string) error {
+	var p struct {
+		Endpoint    string `json:"endpoint"`
+		BearerToken string `json:"bearerToken"`
+	}
+	if err := json.Unmarshal([]byte(profile), &p); err != nil || p.Endpoint == "" {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.Endpoint, "/")+"/shares?maxResults=1", nil)
+	if err != nil {
+		return nil
+	}
+	req.Header.Set("Authorization", "Bearer "+p.BearerToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return &authError{status: resp.StatusCode}
+	}
+	return nil
+}
+
+// isAuthError reports whether err was caused by the server rejecting the
+// profile's bearer token.
+func isAuthError(err error) bool {
+	var ae *authError
+	return errors.As(err, &ae)
+}
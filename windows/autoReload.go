@@ -0,0 +1,197 @@
+package windows
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/widget"
+)
+
+// autoReloadInterval is how often watched files are checked for changes.
+const autoReloadInterval = 2 * time.Second
+
+// fileWatch reloads a table read from local files when they change. A
+// watch with an interval reloads the table every interval instead, for
+// tables whose changes cannot be seen from the files on disk.
+type fileWatch struct {
+	path     string
+	reload   func() (Data, error)
+	stop     chan struct{}
+	interval time.Duration
+	check    *widget.Check
+	// mu guards last, which is set by the watch goroutine.
+	mu   sync.Mutex
+	last time.Time
+}
+
+// lastReload returns when the watch last reloaded its table.
+func (w *fileWatch) lastReload() time.Time {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.last
+}
+
+// refreshText is the label of the auto-refresh check of a table last
+// refreshed at last.
+func refreshText(last time.Time) string {
+	if last.IsZero() {
+		return "Auto-refresh"
+	}
+	return "Auto-refresh (" + last.Format("15:04:05") + ")"
+}
+
+// snapshot describes the names, sizes and modification times of the files
+// below path, so that any change to them changes the snapshot.
+func (w *fileWatch) snapshot() (string, error) {
+	var b strings.Builder
+	err := filepath.WalkDir(w.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	return b.String(), err
+}
+
+func (t *DataBrowser) newAutoReloadCheck(table *widget.Table, dataItem *Data) *widget.Check {
+	text := "Auto-reload"
+	if w := dataItem.watch; w.interval > 0 {
+		text = refreshText(w.lastReload())
+	}
+	check := widget.NewCheck(text, func(on bool) {
+		if on {
+			t.startWatch(table, dataItem)
+		} else {
+			dataItem.watch.close()
+		}
+	})
+	check.Checked = dataItem.watch.stop != nil
+	dataItem.watch.check = check
+	return check
+}
+
+func (w *fileWatch) close() {
+	if w.stop != nil {
+		close(w.stop)
+		w.stop = nil
+	}
+}
+
+// startWatch polls the files of dataItem and reloads its tab once a change
+// has settled. While the files are missing or unreadable, for example in
+// the middle of a rewrite, the current rows stay on screen. A watch with an
+// interval reloads on every tick instead; ticks that come while a reload is
+// still running are skipped.
+func (t *DataBrowser) startWatch(table *widget.Table, dataItem *Data) {
+	w := dataItem.watch
+	if w.stop != nil {
+		return
+	}
+	w.stop = make(chan struct{})
+	stop := w.stop
+	// The interval is read once, as changing it restarts the watch.
+	every := w.interval
+	check := w.check
+	interval := autoReloadInterval
+	last := ""
+	if every > 0 {
+		interval = every
+	} else {
+		last, _ = w.snapshot()
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		pending := ""
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+			snap := ""
+			if every == 0 {
+				var err error
+				snap, err = w.snapshot()
+				if err != nil || snap == last {
+					pending = ""
+					continue
+				}
+				if snap != pending {
+					pending = snap
+					continue
+				}
+			}
+			data, err := w.reload()
+			if err != nil {
+				if !os.IsNotExist(err) {
+					t.setStatus(fmt.Sprintf("Reloading %s failed: %v", w.path, err))
+				}
+				continue
+			}
+			last, pending = snap, ""
+			if !t.replaceTabData(table, dataItem, data, stop) {
+				return
+			}
+			now := time.Now()
+			w.mu.Lock()
+			w.last = now
+			w.mu.Unlock()
+			if every > 0 && check != nil {
+				check.SetText(refreshText(now))
+			}
+		}
+	}()
+}
+
+// replaceTabData swaps the rows of data into dataItem, the table shown in
+// the tab of table, and drops its current rows. The swap is made under the
+// browser lock, so the tab and its settings stay as they are and the table
+// is never drawn half replaced. It reports false, dropping data, once the
+// watch has been stopped.
+func (t *DataBrowser) replaceTabData(table *widget.Table, dataItem *Data, data Data, stop chan struct{}) bool {
+	t.mu.Lock()
+	select {
+	case <-stop:
+		t.mu.Unlock()
+		data.pager.close()
+		return false
+	default:
+	}
+	old := dataItem.pager
+	if old != nil {
+		data.pager.indicator = old.indicator
+		data.pager.banner = old.banner
+		old.close()
+	}
+	dataItem.data, dataItem.nulls = data.data, data.nulls
+	dataItem.header, dataItem.fields = data.header, data.fields
+	dataItem.format, dataItem.location = data.format, data.location
+	dataItem.arrow_table = data.arrow_table
+	dataItem.addFiles = data.addFiles
+	dataItem.pager = data.pager
+	dataItem.quality = nil
+	dataItem.totals = nil
+	dataItem.infos = nil
+	dataItem.duplicates = nil
+	for col := range dataItem.heatmaps {
+		dataItem.heatmaps[col] = nil
+	}
+	t.mu.Unlock()
+
+	t.updateRowHeights(table, dataItem)
+	t.updateColumnWidths(table, dataItem)
+	t.updateTruncation(dataItem)
+	t.setStatus(fmt.Sprintf("Reloaded %s", dataItem.watch.path))
+	return true
+}
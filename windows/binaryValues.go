@@ -0,0 +1,75 @@
+package windows
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+const (
+	binaryHex    = "hex"
+	binaryBase64 = "base64"
+	// binaryDisplayChars caps how much of an encoded binary value is shown
+	// in a cell; copies and exports keep the full value.
+	binaryDisplayChars = 66
+)
+
+// isBinary reports whether col holds Arrow binary values. They are stored
+// hex encoded with a 0x prefix.
+func (d *Data) isBinary(col int) bool {
+	return col < len(d.fields) && d.fields[col].Type.ID() == arrow.BINARY
+}
+
+// cellValue returns the full value of a cell, with binary values in the
+// encoding chosen for their column.
+func (d *Data) cellValue(row, col int) string {
+	v := d.data[row][col]
+	if !d.isBinary(col) || d.binaryModes[col] != binaryBase64 || d.isNull(row, col) {
+		return v
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+	if err != nil {
+		return v
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// cellText is the text shown in a cell, with long binary values and values
+// longer than the maximum cell length cut off.
+func (d *Data) cellText(row, col int) string {
+	v := d.gridValue(row, col)
+	if d.isBinary(col) && len(v) > binaryDisplayChars {
+		return v[:binaryDisplayChars] + "…"
+	}
+	return truncateCell(v)
+}
+
+// binaryMenuItems offers to switch the encoding of a binary column.
+func (t *DataBrowser) binaryMenuItems(dataItem *Data, col int) []*fyne.MenuItem {
+	if !dataItem.isBinary(col) {
+		return nil
+	}
+	set := func(mode string) func() {
+		return func() {
+			t.mu.Lock()
+			if dataItem.binaryModes == nil {
+				dataItem.binaryModes = make(map[int]string)
+			}
+			dataItem.binaryModes[col] = mode
+			t.mu.Unlock()
+			for table, d := range t.tables {
+				if d == dataItem {
+					table.Refresh()
+				}
+			}
+		}
+	}
+	hexItem := fyne.NewMenuItem("Show as Hex", set(binaryHex))
+	hexItem.Checked = dataItem.binaryModes[col] != binaryBase64
+	base64Item := fyne.NewMenuItem("Show as Base64", set(binaryBase64))
+	base64Item.Checked = dataItem.binaryModes[col] == binaryBase64
+	return []*fyne.MenuItem{fyne.NewMenuItemSeparator(), hexItem, base64Item}
+}
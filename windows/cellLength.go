@@ -0,0 +1,58 @@
+package windows
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	maxCellLengthPreference = "maxCellLength"
+	defaultMaxCellLength    = 200
+)
+
+// maxCellLength is the number of characters shown in a cell before the
+// value is cut off, or 0 to show values in full.
+func maxCellLength() int {
+	return fyne.CurrentApp().Preferences().IntWithFallback(maxCellLengthPreference, defaultMaxCellLength)
+}
+
+// truncateCell cuts v off at the maximum cell length. Copies and exports
+// use the full value.
+func truncateCell(v string) string {
+	n := maxCellLength()
+	if n <= 0 || utf8.RuneCountInString(v) <= n {
+		return v
+	}
+	return string([]rune(v)[:n]) + "…"
+}
+
+// isTruncated reports whether the cell at row, col is shown cut off.
+func (d *Data) isTruncated(row, col int) bool {
+	return d.cellText(row, col) != d.gridValue(row, col)
+}
+
+// ShowCellValue shows the full value of a cell in a dialog, from where it
+// can be copied.
+func (t *DataBrowser) ShowCellValue(dataItem *Data, row, col int) {
+	t.mu.Lock()
+	v := dataItem.cellValue(row, col)
+	name := dataItem.header[col]
+	t.mu.Unlock()
+	text := widget.NewMultiLineEntry()
+	text.SetText(v)
+	text.Wrapping = fyne.TextWrapWord
+	text.OnChanged = func(string) { text.SetText(v) }
+	copyValue := widget.NewButtonWithIcon("Copy", theme.ContentCopyIcon(), func() {
+		t.w.Clipboard().SetContent(v)
+	})
+	d := dialog.NewCustom(name+", row "+strconv.Itoa(row+1), "Close",
+		container.NewBorder(nil, copyValue, nil, nil, text), t.w)
+	d.Resize(fyne.NewSize(500, 300))
+	d.Show()
+}
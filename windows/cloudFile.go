@@ -0,0 +1,322 @@
+package windows
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+// cloudObjectURL maps an object store URL to its HTTPS endpoint and returns
+// a function adding the credentials found in the environment to a request:
+//
+//	s3://bucket/key                                AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+//	                                               AWS_SESSION_TOKEN, anonymous without them
+//	gs://bucket/key                                GOOGLE_OAUTH_ACCESS_TOKEN as bearer token
+//	abfss://container@account.dfs.core.windows.net/path  AZURE_STORAGE_SAS_TOKEN
+func cloudObjectURL(rawURL string) (string, func(*http.Request), error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", nil, err
+	}
+	anonymous := func(*http.Request) {}
+	key := strings.TrimPrefix(u.Path, "/")
+	switch u.Scheme {
+	case "s3":
+		region := os.Getenv("AWS_REGION")
+		if region == "" {
+			region = os.Getenv("AWS_DEFAULT_REGION")
+		}
+		if region == "" {
+			region = "us-east-1"
+		}
+		https := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", u.Host, region, key)
+		accessKey, secretKey := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+		if accessKey == "" || secretKey == "" {
+			return https, anonymous, nil
+		}
+		token := os.Getenv("AWS_SESSION_TOKEN")
+		return https, func(req *http.Request) {
+			signS3Request(req, accessKey, secretKey, token, region, time.Now())
+		}, nil
+	case "gs":
+		token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN")
+		if token == "" {
+			return fmt.Sprintf("https://storage.googleapis.com/%s/%s", u.Host, key), anonymous, nil
+		}
+		return fmt.Sprintf("https://storage.googleapis.com/%s/%s", u.Host, key), func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}, nil
+	case "abfss":
+		if u.User == nil {
+			return "", nil, errors.New("abfss URL must have the form abfss://container@account.dfs.core.windows.net/path")
+		}
+		account, _, _ := strings.Cut(u.Host, ".")
+		https := fmt.Sprintf("https://%s.blob.core.windows.net/%s/%s", account, u.User.Username(), key)
+		if sas := os.Getenv("AZURE_STORAGE_SAS_TOKEN"); sas != "" {
+			https += "?" + strings.TrimPrefix(sas, "?")
+		}
+		return https, anonymous, nil
+	}
+	return "", nil, fmt.Errorf("unsupported URL scheme %q, expected s3, gs or abfss", u.Scheme)
+}
+
+// emptyPayloadHash is the SHA-256 of the empty body of GET and HEAD
+// requests.
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// signS3Request signs a GET or HEAD request to S3 with AWS Signature
+// Version 4. It must be called after all other headers are set, as the
+// range of a range request is signed too.
+func signS3Request(req *http.Request, accessKey, secretKey, token, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	day := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	if token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+	signed := []string{"host"}
+	for _, name := range []string{"range", "x-amz-content-sha256", "x-amz-date", "x-amz-security-token"} {
+		if req.Header.Get(name) != "" {
+			signed = append(signed, name)
+		}
+	}
+	var headers strings.Builder
+	for _, name := range signed {
+		v := req.Host
+		if name != "host" {
+			v = req.Header.Get(name)
+		}
+		fmt.Fprintf(&headers, "%s:%s\n", name, strings.TrimSpace(v))
+	}
+	// The path is sent exactly as it is signed.
+	req.URL.RawPath = awsURIEncode(req.URL.Path, false)
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.RawPath,
+		canonicalQuery(req.URL.Query()),
+		headers.String(),
+		strings.Join(signed, ";"),
+		emptyPayloadHash,
+	}, "\n")
+	scope := day + "/" + region + "/s3/aws4_request"
+	hash := sha256.Sum256([]byte(canonical))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+	key := []byte("AWS4" + secretKey)
+	for _, part := range []string{day, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, strings.Join(signed, ";"), signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// awsURIEncode percent-encodes s as Signature Version 4 expects: every byte
+// but letters, digits and -_.~ and, unless encodeSlash is set, slashes.
+func awsURIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// canonicalQuery sorts and encodes the query parameters of a request to be
+// signed.
+func canonicalQuery(query url.Values) string {
+	var pairs []string
+	for name, values := range query {
+		for _, v := range values {
+			pairs = append(pairs, awsURIEncode(name, true)+"="+awsURIEncode(v, true))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// httpFile reads a remote object with HTTP range requests so the Parquet
+// reader only fetches the footer and the column chunks it needs.
+type httpFile struct {
+	ctx       context.Context
+	url       string
+	authorize func(*http.Request)
+	size      int64
+	offset    int64
+}
+
+func openHTTPFile(ctx context.Context, url string, authorize func(*http.Request)) (*httpFile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	authorize(req)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	if resp.ContentLength <= 0 {
+		return nil, fmt.Errorf("%s: unknown object size", url)
+	}
+	return &httpFile{ctx: ctx, url: url, authorize: authorize, size: resp.ContentLength}, nil
+}
+
+// ReadAt reads the bytes at off with a range request. Servers that ignore
+// the range answer with the whole object, of which the bytes before off are
+// skipped.
+func (f *httpFile) ReadAt(p []byte, off int64) (int, error) {
+	if off >= f.size {
+		return 0, io.EOF
+	}
+	end := off + int64(len(p)) - 1
+	if end >= f.size {
+		end = f.size - 1
+	}
+	req, err := http.NewRequestWithContext(f.ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", off, end))
+	f.authorize(req)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		if _, err := io.CopyN(io.Discard, resp.Body, off); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("%s: %s", f.url, resp.Status)
+	}
+	n, err := io.ReadFull(resp.Body, p[:end-off+1])
+	if err == nil && n < len(p) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (f *httpFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.size
+	}
+	if offset < 0 {
+		return 0, errors.New("seek before start of file")
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// OpenCloudFile asks for an object store URL and loads the Parquet file it
+// points to into a new tab.
+func (t *DataBrowser) OpenCloudFile() {
+	entry := widget.NewEntry()
+	entry.SetPlaceHolder("s3://bucket/path/file.parquet")
+	dialog.NewForm("Open Cloud File", "Open", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("URL", entry),
+	}, func(ok bool) {
+		if !ok || entry.Text == "" {
+			return
+		}
+		if err := t.loadCloudParquet(entry.Text); err != nil {
+			t.showError(err)
+		}
+	}, t.w).Show()
+}
+
+// loadCloudParquet opens the Parquet file at rawURL and shows its first row
+// group. The other row groups are read by the row pager as the rows are
+// needed, each with range requests for its column chunks only.
+func (t *DataBrowser) loadCloudParquet(rawURL string) error {
+	httpsURL, authorize, err := cloudObjectURL(rawURL)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	f, err := openHTTPFile(ctx, httpsURL, authorize)
+	if err != nil {
+		return err
+	}
+	props := parquet.NewReaderProperties(memory.DefaultAllocator)
+	props.BufferedStreamEnabled = true
+	pf, err := file.NewParquetReader(f, file.WithReadProps(props))
+	if err != nil {
+		return err
+	}
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: pageSize}, memory.DefaultAllocator)
+	if err != nil {
+		return err
+	}
+	if pf.NumRowGroups() == 0 {
+		empty, err := fr.ReadTable(ctx)
+		if err != nil {
+			return err
+		}
+		t.showArrowTable(empty, path.Base(rawURL), rawURL, nil, nil)
+		return nil
+	}
+	cols := make([]int, pf.MetaData().Schema.NumColumns())
+	for i := range cols {
+		cols[i] = i
+	}
+	load := func(rowGroup string) (arrow.Table, error) {
+		i, err := strconv.Atoi(rowGroup)
+		if err != nil {
+			return nil, err
+		}
+		return fr.ReadRowGroups(ctx, cols, []int{i})
+	}
+	first, err := load("0")
+	if err != nil {
+		return err
+	}
+	rowGroups := make([]string, 0, pf.NumRowGroups()-1)
+	for i := 1; i < pf.NumRowGroups(); i++ {
+		rowGroups = append(rowGroups, strconv.Itoa(i))
+	}
+	t.showArrowTable(first, path.Base(rawURL), rawURL, rowGroups, load)
+	return nil
+}
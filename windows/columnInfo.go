@@ -0,0 +1,114 @@
+package windows
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// columnInfoExamples is the number of example values shown for a column.
+const columnInfoExamples = 5
+
+// columnInfo profiles the loaded values of one column.
+type columnInfo struct {
+	typeName string
+	nullable string
+	nullPct  float64
+	distinct int
+	min, max string
+	examples []string
+}
+
+// columnInfo computes the profile of col on first use and caches it on the
+// table until more rows are loaded.
+func (d *Data) columnInfo(col int) *columnInfo {
+	if info, ok := d.infos[col]; ok {
+		return info
+	}
+	info := &columnInfo{typeName: "unknown", nullable: "unknown"}
+	if col < len(d.fields) {
+		info.typeName = d.fields[col].Type.String()
+		info.nullable = strconv.FormatBool(d.fields[col].Nullable)
+	}
+	nulls := 0
+	seen := make(map[string]bool)
+	for row := range d.data {
+		if d.isNull(row, col) {
+			nulls++
+			continue
+		}
+		v := d.cellValue(row, col)
+		if !seen[v] {
+			seen[v] = true
+			if len(info.examples) < columnInfoExamples {
+				info.examples = append(info.examples, v)
+			}
+		}
+	}
+	info.distinct = len(seen)
+	if len(d.data) > 0 {
+		info.nullPct = 100 * float64(nulls) / float64(len(d.data))
+	}
+
+	category := ""
+	if col < len(d.fields) {
+		category = typeCategory(d.fields[col].Type)
+	}
+	switch category {
+	case "date", "timestamp":
+		// Formatted dates and timestamps sort chronologically as text.
+		for v := range seen {
+			if info.min == "" || v < info.min {
+				info.min = v
+			}
+			if v > info.max {
+				info.max = v
+			}
+		}
+	default:
+		if values, ok := numericColumn(d, col); ok && len(values) > 0 {
+			lo, hi := values[0], values[0]
+			for _, v := range values {
+				lo, hi = min(lo, v), max(hi, v)
+			}
+			info.min = strconv.FormatFloat(lo, 'g', -1, 64)
+			info.max = strconv.FormatFloat(hi, 'g', -1, 64)
+		}
+	}
+
+	if d.infos == nil {
+		d.infos = make(map[int]*columnInfo)
+	}
+	d.infos[col] = info
+	return info
+}
+
+// ShowColumnInfo shows the type and profile of column col of dataItem.
+func (t *DataBrowser) ShowColumnInfo(dataItem *Data, col int) {
+	t.mu.Lock()
+	info := dataItem.columnInfo(col)
+	name := dataItem.header[col]
+	t.mu.Unlock()
+	items := []*widget.FormItem{
+		widget.NewFormItem("Type", widget.NewLabel(info.typeName)),
+		widget.NewFormItem("Nullable", widget.NewLabel(info.nullable)),
+		widget.NewFormItem("Nulls", widget.NewLabel(fmt.Sprintf("%.1f%%", info.nullPct))),
+		widget.NewFormItem("Distinct", widget.NewLabel(strconv.Itoa(info.distinct))),
+	}
+	if info.min != "" {
+		items = append(items,
+			widget.NewFormItem("Min", widget.NewLabel(info.min)),
+			widget.NewFormItem("Max", widget.NewLabel(info.max)))
+	}
+	examples := widget.NewLabel(strings.Join(info.examples, "\n"))
+	examples.Truncation = fyne.TextTruncateEllipsis
+	items = append(items, widget.NewFormItem("Examples", examples))
+
+	d := dialog.NewCustom(name, "Close", widget.NewForm(items...), t.w)
+	d.Resize(fyne.NewSize(400, 0))
+	d.Show()
+}
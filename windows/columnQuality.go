@@ -0,0 +1,45 @@
+package windows
+
+// columnQuality holds the share of null and of distinct values in a column.
+type columnQuality struct {
+	nullPct   float64
+	uniquePct float64
+}
+
+// isNull reports whether a cell is null. Tables that were not loaded from
+// Arrow have no null mask, for those an empty cell counts as null.
+func (d *Data) isNull(row, col int) bool {
+	if d.nulls == nil {
+		return d.data[row][col] == ""
+	}
+	return d.nulls[row][col]
+}
+
+// columnQuality computes the quality figures of every column on first use
+// and caches them on the table.
+func (d *Data) columnQuality() []columnQuality {
+	if d.quality != nil {
+		return d.quality
+	}
+	d.quality = make([]columnQuality, len(d.header))
+	rows := len(d.data)
+	if rows == 0 {
+		return d.quality
+	}
+	for col := range d.header {
+		nulls := 0
+		distinct := make(map[string]struct{})
+		for row := range d.data {
+			if d.isNull(row, col) {
+				nulls++
+				continue
+			}
+			distinct[d.data[row][col]] = struct{}{}
+		}
+		d.quality[col].nullPct = 100 * float64(nulls) / float64(rows)
+		if nonNull := rows - nulls; nonNull > 0 {
+			d.quality[col].uniquePct = 100 * float64(len(distinct)) / float64(nonNull)
+		}
+	}
+	return d.quality
+}
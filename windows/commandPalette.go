@@ -0,0 +1,174 @@
+package windows
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Command is an entry of the command palette.
+type Command struct {
+	Name     string
+	Shortcut string
+	Action   func()
+}
+
+// registerCommands fills the command registry. New actions only need to be
+// added here to become reachable from the palette.
+func (t *MainWindow) registerCommands() {
+	t.commands = []Command{
+		{Name: "Open profile...", Action: func() { t.OpenProfile().Show() }},
+		{Name: "Reload profile", Action: t.ReloadProfile},
+		{Name: "Show profile", Action: t.ShowProfile},
+		{Name: "Refresh shares", Action: t.RefreshShares},
+		{Name: "Recent tables...", Action: t.ShowRecentTables},
+		{Name: "Go to table...", Action: t.GoToTable},
+		{Name: "Settings...", Action: t.ShowSettings},
+		{Name: "Toggle navigation", Action: t.toggleNavigation},
+		{Name: "Paste as table", Action: func() {
+			if err := t.browser().PasteAsTable(); err != nil {
+				t.showError(err)
+			}
+		}},
+		{Name: "Open Parquet folder...", Action: func() { t.browser().OpenParquetFolder() }},
+		{Name: "Open Delta table folder...", Action: func() { t.browser().OpenDeltaFolder() }},
+		{Name: "Open cloud file...", Action: func() { t.browser().OpenCloudFile() }},
+		{Name: "Find in table...", Shortcut: "Ctrl+F", Action: func() { t.browser().Find() }},
+		{Name: "Key columns...", Action: func() { t.browser().EditKeyColumns() }},
+		{Name: "Toggle key columns only", Action: func() { t.browser().ToggleKeyColumns() }},
+		{Name: "Highlight duplicates...", Action: func() { t.browser().HighlightDuplicates() }},
+		{Name: "Export matching rows...", Action: func() { t.browser().ExportFindRows(true) }},
+		{Name: "Export non-matching rows...", Action: func() { t.browser().ExportFindRows(false) }},
+		{Name: "Copy SQL", Action: func() { t.browser().CopySQL() }},
+		{Name: "Copy selection", Shortcut: "Ctrl+C", Action: func() { t.browser().CopySelection() }},
+		{Name: "Go to column...", Shortcut: "Ctrl+L", Action: func() { t.browser().GoToColumn() }},
+		{Name: "Validate against selected table", Action: t.ValidateAgainstSelectedTable},
+		{Name: "Open table in new window", Action: func() { t.browser().OpenInNewWindow() }},
+		{Name: "Next tab", Shortcut: "Ctrl+Tab", Action: func() { t.browser().SelectNextTab(1) }},
+		{Name: "Previous tab", Shortcut: "Ctrl+Shift+Tab", Action: func() { t.browser().SelectNextTab(-1) }},
+		{Name: "Close tab", Shortcut: "Ctrl+W", Action: func() { t.browser().CloseCurrentTab() }},
+		{Name: "Move tab left", Action: func() { t.browser().MoveCurrentTab(-1) }},
+		{Name: "Move tab right", Action: func() { t.browser().MoveCurrentTab(1) }},
+		{Name: "Rename tab...", Action: func() { t.browser().RenameCurrentTab() }},
+		{Name: "Show Parquet stats", Action: func() { t.browser().ShowParquetStats() }},
+		{Name: "Pivot...", Action: func() { t.browser().Pivot() }},
+		{Name: "Export full schema (JSON)...", Action: func() { t.browser().ExportSchema() }},
+		{Name: "Generate data dictionary...", Action: func() { t.browser().GenerateDataDictionary() }},
+		{Name: "Open in spreadsheet", Action: func() { t.browser().OpenInSpreadsheet() }},
+		{Name: "Export tabs...", Action: func() { t.browser().ExportTabs() }},
+		{Name: "Toggle wrap text", Action: func() {
+			t.browser().SetWrapText(!t.browser().wrapText)
+		}},
+		{Name: "Toggle Arrow types in headers", Action: func() {
+			if t.browser().headerTypes == headerTypesArrow {
+				t.browser().SetHeaderTypes(headerTypesOff)
+			} else {
+				t.browser().SetHeaderTypes(headerTypesArrow)
+			}
+		}},
+	}
+}
+
+// paletteCommands returns the registered commands followed by one entry for
+// each share, schema and table currently listed in the navigation.
+func (t *MainWindow) paletteCommands() []Command {
+	cmds := append([]Command{}, t.commands...)
+	for i, name := range t.share {
+		id := i
+		cmds = append(cmds, Command{Name: "Share: " + name, Action: func() { t.shareWidget.Select(id) }})
+	}
+	for i, name := range t.schemas {
+		id := i
+		cmds = append(cmds, Command{Name: "Schema: " + name, Action: func() { t.schemaWidget.Select(id) }})
+	}
+	for i, name := range t.tables {
+		id := i
+		cmds = append(cmds, Command{Name: "Table: " + t.tableLabel(name), Action: func() { t.tablesWidget.Select(id) }})
+	}
+	for _, r := range t.recentTables() {
+		cmds = append(cmds, Command{Name: "Recent: " + r.String(), Action: func() { t.openRecentTable(r) }})
+	}
+	return cmds
+}
+
+// fuzzyMatch reports whether the characters of pattern appear in s in order,
+// ignoring case.
+func fuzzyMatch(pattern, s string) bool {
+	s = strings.ToLower(s)
+	for _, r := range strings.ToLower(pattern) {
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(string(r)):]
+	}
+	return true
+}
+
+// ShowCommandPalette opens a search box listing all commands. Enter runs the
+// first match, Escape closes the palette.
+func (t *MainWindow) ShowCommandPalette() {
+	all := t.paletteCommands()
+	matches := all
+
+	var popUp *widget.PopUp
+	run := func(cmd Command) {
+		popUp.Hide()
+		cmd.Action()
+	}
+
+	list := widget.NewList(func() int {
+		return len(matches)
+	}, func() fyne.CanvasObject {
+		return container.NewBorder(nil, nil, nil, widget.NewLabel(""), widget.NewLabel(""))
+	}, func(id widget.ListItemID, co fyne.CanvasObject) {
+		c := co.(*fyne.Container)
+		c.Objects[0].(*widget.Label).SetText(matches[id].Name)
+		c.Objects[1].(*widget.Label).SetText(matches[id].Shortcut)
+	})
+	list.OnSelected = func(id widget.ListItemID) {
+		run(matches[id])
+	}
+
+	entry := &paletteEntry{onEscape: func() { popUp.Hide() }}
+	entry.ExtendBaseWidget(entry)
+	entry.SetPlaceHolder("Type a command or table name")
+	entry.OnChanged = func(s string) {
+		matches = make([]Command, 0)
+		for _, cmd := range all {
+			if fuzzyMatch(s, cmd.Name) {
+				matches = append(matches, cmd)
+			}
+		}
+		list.UnselectAll()
+		list.Refresh()
+	}
+	entry.OnSubmitted = func(string) {
+		if len(matches) > 0 {
+			run(matches[0])
+		}
+	}
+
+	popUp = widget.NewPopUp(container.NewBorder(entry, nil, nil, nil, list), t.w.Canvas())
+	size := fyne.NewSize(400, 300)
+	popUp.Resize(size)
+	popUp.ShowAtPosition(fyne.NewPos((t.w.Canvas().Size().Width-size.Width)/2, 60))
+	t.w.Canvas().Focus(entry)
+}
+
+// paletteEntry is the search box of the command palette, closing it on
+// Escape.
+type paletteEntry struct {
+	widget.Entry
+	onEscape func()
+}
+
+func (e *paletteEntry) TypedKey(ke *fyne.KeyEvent) {
+	if ke.Name == fyne.KeyEscape {
+		e.onEscape()
+		return
+	}
+	e.Entry.TypedKey(ke)
+}
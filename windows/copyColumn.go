@@ -0,0 +1,137 @@
+package windows
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	// copyColumnWarnRows is the number of values above which copying a
+	// column asks for confirmation first, offering a file export instead.
+	copyColumnWarnRows = 10000
+	// copyProgressRows is the number of values above which a progress
+	// dialog is shown while the clipboard text is built.
+	copyProgressRows = 2000
+)
+
+var columnSeparators = map[string]func([]string) string{
+	"One per line":    func(v []string) string { return strings.Join(v, "\n") },
+	"Comma separated": func(v []string) string { return strings.Join(v, ", ") },
+	"Quoted, comma separated": func(v []string) string {
+		quoted := make([]string, len(v))
+		for i, s := range v {
+			quoted[i] = "'" + strings.ReplaceAll(s, "'", "''") + "'"
+		}
+		return strings.Join(quoted, ", ")
+	},
+}
+
+// menuHeader is a table header that shows a context menu when it is right
+// clicked.
+type menuHeader struct {
+	widget.RichText
+	menu func() *fyne.Menu
+}
+
+func newMenuHeader() *menuHeader {
+	h := &menuHeader{}
+	h.ExtendBaseWidget(h)
+	h.Truncation = fyne.TextTruncateClip
+	return h
+}
+
+func (h *menuHeader) TappedSecondary(e *fyne.PointEvent) {
+	if h.menu == nil {
+		return
+	}
+	c := fyne.CurrentApp().Driver().CanvasForObject(h)
+	widget.ShowPopUpMenuAtPosition(h.menu(), c, e.AbsolutePosition)
+}
+
+// columnValues returns the non-null values of col, optionally without
+// duplicates, in row order.
+func columnValues(dataItem *Data, col int, distinct bool) []string {
+	seen := make(map[string]bool)
+	values := make([]string, 0, len(dataItem.data))
+	for row := range dataItem.data {
+		v := dataItem.cellValue(row, col)
+		if dataItem.isNull(row, col) || (distinct && seen[v]) {
+			continue
+		}
+		seen[v] = true
+		values = append(values, v)
+	}
+	return values
+}
+
+// CopyColumnValues puts the loaded values of column col on the clipboard
+// with a separator chosen by the user.
+func (t *DataBrowser) CopyColumnValues(dataItem *Data, col int) {
+	separator := widget.NewSelect([]string{"One per line", "Comma separated", "Quoted, comma separated"}, nil)
+	separator.SetSelected("One per line")
+	distinct := widget.NewCheck("Remove duplicates", nil)
+	t.mu.Lock()
+	name := dataItem.header[col]
+	t.mu.Unlock()
+
+	dialog.NewForm("Copy "+name+" Values", "Copy", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("Separator", separator),
+		widget.NewFormItem("", distinct),
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		t.mu.Lock()
+		values := columnValues(dataItem, col, distinct.Checked)
+		t.mu.Unlock()
+		join := columnSeparators[separator.Selected]
+		if len(values) <= copyColumnWarnRows {
+			t.copyToClipboard(values, join)
+			return
+		}
+		var d *dialog.CustomDialog
+		d = dialog.NewCustomWithoutButtons("Large Column",
+			widget.NewLabel(fmt.Sprintf("Copying %d values to the clipboard may be slow.", len(values))), t.w)
+		d.SetButtons([]fyne.CanvasObject{
+			widget.NewButton("Cancel", d.Hide),
+			widget.NewButton("Export to File...", func() {
+				d.Hide()
+				rows := make([][]string, len(values))
+				for i, v := range values {
+					rows[i] = []string{v}
+				}
+				column := Data{header: []string{name}, data: rows, source: dataItem.source}
+				t.saveExport(column, exportOptions{format: currentNumberFormat()}, exportFormats[0])
+			}),
+			widget.NewButton("Copy", func() {
+				d.Hide()
+				t.copyToClipboard(values, join)
+			}),
+		})
+		d.Show()
+	}, t.w).Show()
+}
+
+// copyToClipboard joins values and puts them on the clipboard, showing
+// progress for large columns and confirming the copy in the status bar.
+func (t *DataBrowser) copyToClipboard(values []string, join func([]string) string) {
+	done := func(text string) {
+		t.w.Clipboard().SetContent(text)
+		t.setStatus(fmt.Sprintf("Copied %d values to the clipboard", len(values)))
+	}
+	if len(values) <= copyProgressRows {
+		done(join(values))
+		return
+	}
+	progress := dialog.NewCustomWithoutButtons("Copying", widget.NewProgressBarInfinite(), t.w)
+	progress.Show()
+	go func() {
+		text := join(values)
+		progress.Hide()
+		done(text)
+	}()
+}
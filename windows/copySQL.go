@@ -0,0 +1,58 @@
+package windows
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var plainIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// quoteIdentifier quotes name for SQL unless it is a plain identifier.
+func quoteIdentifier(name string) string {
+	if plainIdentifier.MatchString(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// selectStatement builds a SELECT of the columns shown in the tab of
+// dataItem: its key columns while the tab shows only those. Tables that are
+// still partly read are limited to the rows loaded so far.
+func selectStatement(dataItem *Data) string {
+	keys := dataItem.keyColumns()
+	var columns []string
+	for _, name := range dataItem.header {
+		if !dataItem.keyOnly || len(keys) == 0 || keys[name] {
+			columns = append(columns, quoteIdentifier(name))
+		}
+	}
+	parts := strings.Split(dataItem.source, ".")
+	for i, p := range parts {
+		parts[i] = quoteIdentifier(p)
+	}
+	sql := fmt.Sprintf("SELECT %s\nFROM %s", strings.Join(columns, ", "), strings.Join(parts, "."))
+	if p := dataItem.pager; p != nil && !p.finished() {
+		sql += fmt.Sprintf("\nLIMIT %d", len(dataItem.data))
+	}
+	return sql
+}
+
+// CopySQL puts a SELECT statement reproducing the current Delta Sharing tab
+// on the clipboard.
+func (t *DataBrowser) CopySQL() {
+	_, dataItem := t.currentTable()
+	if dataItem == nil {
+		return
+	}
+	t.mu.Lock()
+	shared := dataItem.addFiles != nil
+	sql := selectStatement(dataItem)
+	t.mu.Unlock()
+	if !shared {
+		t.showError(fmt.Errorf("%s was not loaded from a Delta Sharing table", dataItem.source))
+		return
+	}
+	t.w.Clipboard().SetContent(sql)
+	t.setStatus("Copied SELECT statement for " + dataItem.source)
+}
@@ -0,0 +1,45 @@
+package windows
+
+import "fyne.io/fyne/v2"
+
+const csvDelimiterPreference = "csvDelimiter"
+
+// csvDelimiterDefault leaves the delimiter to the number format, so
+// European exports stay semicolon separated.
+const csvDelimiterDefault = "As number format"
+
+// csvDelimiters are the delimiters exported CSV files can be written with.
+var csvDelimiters = []struct {
+	name  string
+	comma rune
+}{
+	{csvDelimiterDefault, 0},
+	{"Comma", ','},
+	{"Semicolon", ';'},
+	{"Tab", '\t'},
+	{"Pipe", '|'},
+}
+
+func csvDelimiterNames() []string {
+	names := make([]string, len(csvDelimiters))
+	for i, d := range csvDelimiters {
+		names[i] = d.name
+	}
+	return names
+}
+
+// currentCSVDelimiter is the name of the delimiter chosen in the settings.
+func currentCSVDelimiter() string {
+	return fyne.CurrentApp().Preferences().StringWithFallback(csvDelimiterPreference, csvDelimiterDefault)
+}
+
+// withCSVDelimiter returns f writing CSV with the named delimiter. The
+// default and unknown names keep the delimiter of f.
+func (f numberFormat) withCSVDelimiter(name string) numberFormat {
+	for _, d := range csvDelimiters {
+		if d.name == name && d.comma != 0 {
+			f.comma = d.comma
+		}
+	}
+	return f
+}
@@ -2,13 +2,20 @@ package windows
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"image/color"
 	"log"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
@@ -18,47 +25,438 @@ import (
 type Data struct {
 	data        [][]string
 	header      []string
+	fields      []arrow.Field
+	nulls       [][]bool
+	quality     []columnQuality
+	source      string
+	profile     string
+	name        string
+	format      numberFormat
+	location    *time.Location
+	findTerm    string
+	selectMode  string
+	keyOnly     bool
+	dupColumns  []int
+	duplicates  map[int]bool
+	aggregates  map[int]string
+	totals      []string
+	binaryModes map[int]string
+	precisions  map[int]int
+	alignments  map[int]fyne.TextAlign
+	heatmaps    map[int]*heatRange
+	wrapColumns map[int]bool
+	infos       map[int]*columnInfo
+	watch       *fileWatch
+	pager       *rowPager
+	addFiles    []delta_sharing.File
 	arrow_table arrow.Table
-	arrow_rec   arrow.Record
 	tab         container.TabItem
 }
 
 type DataBrowser struct {
-	w       fyne.Window
-	content fyne.Container
-	Data    []Data
-	tabs    []*container.TabItem
-	docTabs *container.DocTabs
+	// mu guards the rows, header and display settings of the open tables,
+	// and the selection and zoom of their widgets, as background loads
+	// append rows and redraw tables while the user works with them. It is
+	// only held while they are read or written, never around a table
+	// refresh, whose callbacks take it themselves.
+	mu          sync.Mutex
+	w           fyne.Window
+	content     fyne.Container
+	tabs        []*container.TabItem
+	tables      map[*widget.Table]*Data
+	tabTables   map[*container.TabItem]*widget.Table
+	findBars    map[*widget.Table]*findBar
+	zoom        map[*widget.Table]float32
+	schemaTrees map[*widget.Table]fyne.CanvasObject
+	keyChecks   map[*widget.Table]*widget.Check
+	selection   map[*widget.Table]widget.TableCellID
+	tempFiles   []string
+	innerTabs   *container.DocTabs
+	docTabs     *container.DocTabs
+	wrapText    bool
+	headerTypes string
+	showQuality bool
+	showTotals  bool
+	showSchema  bool
+	showError   func(error)
+	setStatus   func(string)
 }
 
+const (
+	wrapTextPreference    = "wrapText"
+	headerTypesPreference = "headerTypes"
+	showQualityPreference = "showColumnQuality"
+)
+
 func (t *DataBrowser) CreateWindow(docTabs *container.DocTabs) {
 	t.w = fyne.CurrentApp().Driver().AllWindows()[0]
 	t.docTabs = docTabs
-	t.Data = make([]Data, 0)
+	t.tables = make(map[*widget.Table]*Data)
+	t.tabTables = make(map[*container.TabItem]*widget.Table)
+	t.findBars = make(map[*widget.Table]*findBar)
+	t.zoom = make(map[*widget.Table]float32)
+	t.schemaTrees = make(map[*widget.Table]fyne.CanvasObject)
+	t.keyChecks = make(map[*widget.Table]*widget.Check)
+	t.selection = make(map[*widget.Table]widget.TableCellID)
+	t.wrapText = fyne.CurrentApp().Preferences().Bool(wrapTextPreference)
+	t.headerTypes = fyne.CurrentApp().Preferences().StringWithFallback(headerTypesPreference, headerTypesOff)
+	t.showQuality = fyne.CurrentApp().Preferences().Bool(showQualityPreference)
+	t.showTotals = fyne.CurrentApp().Preferences().Bool(showTotalsPreference)
+	t.showSchema = fyne.CurrentApp().Preferences().Bool(showSchemaPreference)
+	t.showError = func(err error) {
+		dialog.NewError(err, t.w).Show()
+	}
+	t.setStatus = func(string) {}
 }
 
-func (t *DataBrowser) CreateDataBrowser(dataItem *Data, delta_table delta_sharing.Table) {
-	table := widget.NewTableWithHeaders(func() (rows int, cols int) {
-		return len(dataItem.data), len(dataItem.data[0])
+// SetWrapText switches all open tables between truncating long cell values
+// and wrapping them, growing the row heights to fit the wrapped text.
+// Columns wrapped from their header menu stay wrapped when it is off.
+func (t *DataBrowser) SetWrapText(wrap bool) {
+	t.wrapText = wrap
+	fyne.CurrentApp().Preferences().SetBool(wrapTextPreference, wrap)
+	for table, dataItem := range t.tables {
+		t.updateRowHeights(table, dataItem)
+		table.Refresh()
+	}
+}
+
+// Modes of the type line below the column names.
+const (
+	headerTypesOff      = "Off"
+	headerTypesCategory = "Type category"
+	headerTypesArrow    = "Arrow type"
+)
+
+var headerTypeModes = []string{headerTypesOff, headerTypesCategory, headerTypesArrow}
+
+// SetHeaderTypes chooses what the secondary header line shows for each
+// column: nothing, a broad type category, or the exact Arrow type such as
+// timestamp[us, tz=UTC] or decimal(10, 2).
+func (t *DataBrowser) SetHeaderTypes(mode string) {
+	t.headerTypes = mode
+	fyne.CurrentApp().Preferences().SetString(headerTypesPreference, mode)
+	for table := range t.tables {
+		t.updateHeaderHeight(table)
+		table.Refresh()
+	}
+}
+
+// SetShowQuality toggles a header line with the null and unique percentage
+// of each column. The figures are computed once per table on first use.
+func (t *DataBrowser) SetShowQuality(show bool) {
+	t.showQuality = show
+	fyne.CurrentApp().Preferences().SetBool(showQualityPreference, show)
+	for table := range t.tables {
+		t.updateHeaderHeight(table)
+		table.Refresh()
+	}
+}
+
+var headerNameStyle = widget.RichTextStyle{
+	ColorName: theme.ColorNameForeground,
+	SizeName:  theme.SizeNameText,
+}
+
+var headerDetailStyle = widget.RichTextStyle{
+	ColorName: theme.ColorNamePlaceHolder,
+	SizeName:  theme.SizeNameCaptionText,
+	TextStyle: fyne.TextStyle{Italic: true},
+}
+
+func (t *DataBrowser) headerLines() int {
+	lines := 1
+	if t.headerTypes != headerTypesOff {
+		lines++
+	}
+	if t.showQuality {
+		lines++
+	}
+	if t.showTotals {
+		lines++
+	}
+	return lines
+}
+
+func (t *DataBrowser) updateHeaderHeight(table *widget.Table) {
+	sample := widget.NewRichText(&widget.TextSegment{Text: "M", Style: headerNameStyle})
+	for i := 1; i < t.headerLines(); i++ {
+		sample.Segments = append(sample.Segments, &widget.TextSegment{Text: "M", Style: headerDetailStyle})
+	}
+	table.SetRowHeight(-1, sample.MinSize().Height*t.zoomOf(table))
+}
+
+func (t *DataBrowser) headerSegments(dataItem *Data, col int) []widget.RichTextSegment {
+	segments := []widget.RichTextSegment{
+		&widget.TextSegment{Text: dataItem.header[col], Style: headerNameStyle},
+	}
+	if t.headerTypes != headerTypesOff {
+		typeName := ""
+		if col < len(dataItem.fields) {
+			typeName = dataItem.fields[col].Type.String()
+			if t.headerTypes == headerTypesCategory {
+				typeName = typeCategory(dataItem.fields[col].Type)
+			}
+		}
+		segments = append(segments, &widget.TextSegment{Text: typeName, Style: headerDetailStyle})
+	}
+	if t.showQuality {
+		q := dataItem.columnQuality()[col]
+		segments = append(segments, &widget.TextSegment{
+			Text:  fmt.Sprintf("null %.0f%% · unique %.0f%%", q.nullPct, q.uniquePct),
+			Style: headerDetailStyle,
+		})
+	}
+	if t.showTotals {
+		segments = append(segments, &widget.TextSegment{
+			Text:  dataItem.columnTotals()[col],
+			Style: headerDetailStyle,
+		})
+	}
+	return segments
+}
+
+// typeCategory groups Arrow types into the categories shown in the header.
+func typeCategory(t arrow.DataType) string {
+	switch t.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return "integer"
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return "float"
+	case arrow.DECIMAL128, arrow.DECIMAL256:
+		return "decimal"
+	case arrow.STRING, arrow.LARGE_STRING:
+		return "text"
+	case arrow.BINARY, arrow.LARGE_BINARY, arrow.FIXED_SIZE_BINARY:
+		return "binary"
+	case arrow.BOOL:
+		return "boolean"
+	case arrow.DATE32, arrow.DATE64:
+		return "date"
+	case arrow.TIMESTAMP:
+		return "timestamp"
+	case arrow.STRUCT:
+		return "struct"
+	case arrow.LIST, arrow.LARGE_LIST:
+		return "list"
+	case arrow.MAP:
+		return "map"
+	}
+	return strings.ToLower(t.ID().String())
+}
+
+func (t *DataBrowser) updateRowHeights(table *widget.Table, dataItem *Data) {
+	zoom := t.zoomOf(table)
+	template := widget.NewLabel("template.............").MinSize()
+	template.Height *= zoom
+	template.Width *= zoom
+	t.mu.Lock()
+	wrapped := dataItem.wrappedColumns(t.wrapText)
+	t.mu.Unlock()
+	if len(wrapped) == 0 {
+		t.mu.Lock()
+		rows := len(dataItem.data)
+		t.mu.Unlock()
+		for row := 0; row < rows; row++ {
+			table.SetRowHeight(row, template.Height)
+		}
+		return
+	}
+	textSize := theme.TextSize() * zoom
+	lineHeight := fyne.MeasureText("M", textSize, fyne.TextStyle{}).Height
+	width := template.Width - 2*theme.InnerPadding()*zoom
+	t.mu.Lock()
+	heights := make([]float32, len(dataItem.data))
+	for row, values := range dataItem.data {
+		lines := 1
+		for _, col := range wrapped {
+			if col >= len(values) {
+				continue
+			}
+			textWidth := fyne.MeasureText(values[col], textSize, fyne.TextStyle{}).Width
+			if n := int(textWidth/width) + 1; n > lines {
+				lines = n
+			}
+		}
+		heights[row] = template.Height + float32(lines-1)*lineHeight
+	}
+	t.mu.Unlock()
+	for row, height := range heights {
+		table.SetRowHeight(row, height)
+	}
+}
+
+// newDataTable creates a table widget showing the rows of dataItem and
+// registers it with the browser.
+func (t *DataBrowser) newDataTable(dataItem *Data) *widget.Table {
+	if dataItem.selectMode == "" {
+		dataItem.selectMode = defaultSelectionMode()
+	}
+	var table *widget.Table
+	table = widget.NewTableWithHeaders(func() (rows int, cols int) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		return len(dataItem.data), len(dataItem.header)
 	}, func() fyne.CanvasObject {
-		return widget.NewLabel("template.............")
+		return container.NewStack(canvas.NewRectangle(color.Transparent), widget.NewLabel("template............."))
 	}, func(tci widget.TableCellID, co fyne.CanvasObject) {
-		co.(*widget.Label).SetText(dataItem.data[tci.Row][tci.Col])
-		co.(*widget.Label).Truncation = fyne.TextTruncateClip
+		cell := co.(*fyne.Container)
+		background := cell.Objects[0].(*canvas.Rectangle)
+		label := cell.Objects[1].(*widget.Label)
+		t.mu.Lock()
+		heat, isHeat := dataItem.heatColor(tci.Row, tci.Col)
+		text := dataItem.cellText(tci.Row, tci.Col)
+		match := dataItem.isFindMatch(text)
+		alignment := dataItem.alignment(tci.Col)
+		selected := t.isSelectedRow(table, dataItem, tci.Row)
+		duplicate := dataItem.isDuplicate(tci.Row)
+		nearEnd := tci.Row >= len(dataItem.data)-loadMoreThreshold
+		wrap := t.wrapText || dataItem.wrapColumns[tci.Col]
+		t.mu.Unlock()
+		if isHeat {
+			background.FillColor = heat
+		} else {
+			background.FillColor = color.Transparent
+		}
+		background.Refresh()
+		if wrap {
+			label.Wrapping = fyne.TextWrapWord
+			label.Truncation = fyne.TextTruncateOff
+		} else {
+			label.Wrapping = fyne.TextWrapOff
+			label.Truncation = fyne.TextTruncateClip
+		}
+		label.TextStyle.Bold = match
+		label.Alignment = alignment
+		switch {
+		case selected:
+			label.Importance = widget.HighImportance
+		case duplicate:
+			label.Importance = widget.WarningImportance
+		default:
+			label.Importance = widget.MediumImportance
+		}
+		label.SetText(text)
+		if nearEnd {
+			t.loadMore(table, dataItem)
+		}
 	})
 
 	table.ShowHeaderColumn = false
+	table.CreateHeader = func() fyne.CanvasObject {
+		return newMenuHeader()
+	}
 	table.UpdateHeader = func(id widget.TableCellID, template fyne.CanvasObject) {
-		template.(*widget.Label).SetText(dataItem.header[id.Col])
-		template.(*widget.Label).Truncation = fyne.TextTruncateClip
+		header := template.(*menuHeader)
+		t.mu.Lock()
+		header.Segments = t.headerSegments(dataItem, id.Col)
+		t.mu.Unlock()
+		header.menu = func() *fyne.Menu {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			items := []*fyne.MenuItem{
+				fyne.NewMenuItem("Column Info...", func() {
+					t.ShowColumnInfo(dataItem, id.Col)
+				}),
+				fyne.NewMenuItem("Value Distribution...", func() {
+					t.ShowValueDistribution(dataItem, id.Col)
+				}),
+				fyne.NewMenuItem("Copy Column Values...", func() {
+					t.CopyColumnValues(dataItem, id.Col)
+				}),
+			}
+			if item := t.precisionMenuItem(dataItem, id.Col); item != nil {
+				items = append(items, item)
+			}
+			if item := t.heatmapMenuItem(dataItem, id.Col); item != nil {
+				items = append(items, item)
+			}
+			items = append(items, t.wrapMenuItem(dataItem, id.Col))
+			items = append(items, t.alignmentMenuItems(dataItem, id.Col)...)
+			return fyne.NewMenu("", append(items, t.binaryMenuItems(dataItem, id.Col)...)...)
+		}
+		header.Refresh()
+	}
+
+	table.OnSelected = func(id widget.TableCellID) {
+		t.mu.Lock()
+		t.selection[table] = id
+		summary := selectionSummary(dataItem, id)
+		truncated := dataItem.isTruncated(id.Row, id.Col)
+		t.mu.Unlock()
+		t.setStatus(summary)
+		if dataItem.selectMode == selectionModeRow {
+			table.Refresh()
+		} else if truncated {
+			t.ShowCellValue(dataItem, id.Row, id.Col)
+		}
 	}
+	table.OnUnselected = func(widget.TableCellID) {
+		t.mu.Lock()
+		delete(t.selection, table)
+		t.mu.Unlock()
+		t.setStatus("")
+		if dataItem.selectMode == selectionModeRow {
+			table.Refresh()
+		}
+	}
+
+	t.tables[table] = dataItem
+	t.updateRowHeights(table, dataItem)
+	t.updateHeaderHeight(table)
+	return table
+}
 
-	content := widget.NewCard("", "", table)
-	t.tabs = append(t.tabs, container.NewTabItem(delta_table.Name, content))
+// newTabContent builds the content of a data tab: the table with its find
+// bar, truncation banner, zoom controls, record view and schema tree.
+func (t *DataBrowser) newTabContent(dataItem *Data) (fyne.CanvasObject, *widget.Table) {
+	table := t.newDataTable(dataItem)
 
-	tabs := container.NewDocTabs(t.tabs...)
-	tabs.CloseIntercept = func(ti *container.TabItem) {
+	loading := widget.NewLabel("Loading more...")
+	loading.Hide()
+	find := t.newFindBar(table, dataItem)
+	t.findBars[table] = find
+	top := container.NewVBox(find.box)
+	if dataItem.pager != nil {
+		dataItem.pager.indicator = loading
+		dataItem.pager.banner = t.newTruncationBanner(table, dataItem)
+		top.Add(dataItem.pager.banner.box)
+		t.updateTruncation(dataItem)
 	}
+
+	zoomed, zoomControls := t.newZoomControls(table, dataItem)
+	t.updateColumnWidths(table, dataItem)
+	record := t.newRecordView(table, dataItem)
+	controls := container.NewHBox(t.newRecordViewCheck(table, dataItem, zoomed, record),
+		t.newKeyColumnsCheck(table, dataItem), widget.NewLabel("Select:"), t.newSelectionModeSelect(table, dataItem), zoomControls)
+	if dataItem.watch != nil {
+		watchControls := []fyne.CanvasObject{t.newAutoReloadCheck(table, dataItem)}
+		if dataItem.watch.interval > 0 {
+			watchControls = append(watchControls, t.newRefreshIntervalSelect(table, dataItem))
+		}
+		controls.Objects = append(watchControls, controls.Objects...)
+	}
+	bottom := container.NewBorder(nil, nil, loading, controls)
+	schemaTree := t.newSchemaTree(table, dataItem)
+	t.schemaTrees[table] = schemaTree
+	content := widget.NewCard("", "", container.NewBorder(top, bottom, nil, schemaTree, container.NewStack(zoomed, record.content)))
+	return content, table
+}
+
+func (t *DataBrowser) CreateDataBrowser(dataItem *Data, name string) {
+	content, table := t.newTabContent(dataItem)
+	dataItem.name = name
+	tab := container.NewTabItem(name, content)
+	t.tabs = append(t.tabs, tab)
+	t.tabTables[tab] = table
+
+	// The tabs widget gets its own copy of the tabs, as it edits its items
+	// in place when a tab is closed.
+	tabs := container.NewDocTabs(slices.Clone(t.tabs)...)
+	t.innerTabs = tabs
+	tabs.CloseIntercept = t.closeTab
+	tabs.OnSelected = t.stopLoadsExcept
 	tabs.SetTabLocation(container.TabLocationBottom)
 
 	for _, v := range t.docTabs.Items {
@@ -67,7 +465,28 @@ func (t *DataBrowser) CreateDataBrowser(dataItem *Data, delta_table delta_sharin
 		}
 	}
 
-	browserAccordionItem := widget.NewAccordionItem("Browser", tabs)
+	wrapCheck := widget.NewCheck("Wrap text", t.SetWrapText)
+	wrapCheck.Checked = t.wrapText
+	typesSelect := widget.NewSelect(headerTypeModes, t.SetHeaderTypes)
+	typesSelect.Selected = t.headerTypes
+	qualityCheck := widget.NewCheck("Column quality", t.SetShowQuality)
+	qualityCheck.Checked = t.showQuality
+	totalsCheck := widget.NewCheck("Totals", t.SetShowTotals)
+	totalsCheck.Checked = t.showTotals
+	schemaCheck := widget.NewCheck("Schema", t.SetShowSchema)
+	schemaCheck.Checked = t.showSchema
+	options := container.NewHBox(wrapCheck, widget.NewLabel("Types:"), typesSelect, qualityCheck, totalsCheck, schemaCheck,
+		widget.NewButton("Totals...", t.ChooseTotals),
+		widget.NewButton("Pivot...", t.Pivot),
+		widget.NewButton("Go to column...", t.GoToColumn),
+		widget.NewButton("Key columns...", t.EditKeyColumns),
+		widget.NewButton("Duplicates...", t.HighlightDuplicates),
+		widget.NewButton("Files", t.ShowFiles),
+		widget.NewButton("Parquet stats", t.ShowParquetStats),
+		widget.NewButton("New window", t.OpenInNewWindow),
+		widget.NewButton("Export tabs...", t.ExportTabs))
+
+	browserAccordionItem := widget.NewAccordionItem("Browser", container.NewBorder(options, nil, nil, nil, tabs))
 	browserAccordionItem.Open = true
 	accordion := widget.NewAccordion(browserAccordionItem)
 	t.docTabs.Append(container.NewTabItem("Browser", accordion))
@@ -77,7 +496,7 @@ func (t *DataBrowser) CreateDataBrowser(dataItem *Data, delta_table delta_sharin
 	t.docTabs.SelectIndex(2)
 }
 
-func (t *DataBrowser) GetData(profile string, table delta_sharing.Table, file_id string) {
+func (t *DataBrowser) GetData(profile, profileName string, table delta_sharing.Table, file_id string) {
 	c := make(chan bool)
 	go func(c chan bool) {
 		pbi := widget.NewProgressBarInfinite()
@@ -99,128 +518,251 @@ func (t *DataBrowser) GetData(profile string, table delta_sharing.Table, file_id
 	}(c)
 	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), profile, "")
 	if err != nil {
-		dialog.NewError(err, t.w).Show()
+		t.showError(err)
 	}
 	resp, err := ds.ListFilesInTable(table)
 	if err != nil {
-		dialog.NewError(err, t.w).Show()
+		t.showError(err)
+	}
+	var remaining []string
+	for _, v := range resp.AddFiles {
+		if v.Id != file_id {
+			remaining = append(remaining, v.Id)
+		}
+	}
+	load := func(fileID string) (arrow.Table, error) {
+		return delta_sharing.LoadArrowTable(ds, table, fileID)
 	}
-	var data Data
 	for _, v := range resp.AddFiles {
 		if v.Id == file_id {
 			arrow_table, err := delta_sharing.LoadArrowTable(ds, table, file_id)
 			if err != nil {
-				dialog.NewError(err, t.w).Show()
+				t.showError(err)
 			}
-			data.arrow_table = arrow_table
-			data.arrow_table, err = t.test(data.arrow_table)
+			arrow_table, err = t.test(arrow_table)
 			if err != nil {
 				fmt.Println(err)
 				c <- true
 				return
 			}
-			var header []string = make([]string, data.arrow_table.NumCols())
-			for i, f := range data.arrow_table.Schema().Fields() {
-				header[i] = f.Name
+			data := t.newArrowData(arrow_table, qualifiedName(table.Share, table.Schema, table.Name), remaining, load)
+			data.profile = profileName
+			data.addFiles = resp.AddFiles
+			data.watch = t.sharedTableWatch(profile, table)
+			dt := &data
+			t.CreateDataBrowser(dt, profileTabTitle(profileName, table.Name))
+			t.updateTruncation(dt)
+			if fyne.CurrentApp().Preferences().Bool(backgroundLoadPreference) {
+				t.loadAll(t.tabTables[t.tabs[len(t.tabs)-1]], dt)
 			}
 
-			data.data = make([][]string, 0)
-			data.header = header
-
-			tr := array.NewTableReader(data.arrow_table, 1000)
-			tr.Retain()
-			tr.Next()
-			data.arrow_rec = tr.Record()
-			t.Data = append(t.Data, data)
-			dt := t.parseRecord()
-
-			t.CreateDataBrowser(dt, table)
-
 			c <- true
 			t.w.Content().Refresh()
 		}
 	}
 }
 
-func (t *DataBrowser) parseRecord() *Data {
-	dp := len(t.Data) - 1
-	for pos := 0; pos < int(t.Data[dp].arrow_rec.NumRows()); pos++ {
-		var v []string = make([]string, t.Data[dp].arrow_rec.NumCols())
-		for i, col := range t.Data[dp].arrow_rec.Columns() {
-			switch col.DataType().ID() {
-			case arrow.STRUCT:
-				s := col.(*array.Struct)
-
-				b, err := s.MarshalJSON()
-				if err != nil {
-					log.Fatal(err)
-				}
-				v[i] = string(b)
-
-			case arrow.LIST:
-				as := array.NewSlice(col, int64(pos), int64(pos+1))
-				str := fmt.Sprintf("%v", as)
-				if len(str) > 253 {
-					v[i] = str[1:253] + "..."
-				} else {
-					v[i] = str
-				}
-			case arrow.STRING:
-				s := col.(*array.String)
-				v[i] = s.Value(pos)
-			case arrow.BINARY:
-				b := col.(*array.Binary)
-				v[i] = string(b.Value(pos))
-			case arrow.BOOL:
-				b := col.(*array.Boolean)
-				v[i] = fmt.Sprintf("%v", b.Value(pos))
-			case arrow.DATE32:
-				d32 := col.(*array.Date32)
-				v[i] = d32.Value(pos).ToTime().String()
-			case arrow.DATE64:
-				d64 := col.(*array.Date64)
-				v[i] = d64.Value(pos).ToTime().String()
-			case arrow.DECIMAL:
-				d128 := col.(*array.Decimal128)
-				v[i] = d128.Value(pos).BigInt().String()
-			case arrow.INT8:
-				i8 := col.(*array.Int8)
-				v[i] = fmt.Sprintf("%d", i8.Value(pos))
-			case arrow.INT16:
-				i16 := col.(*array.Int16)
-				v[i] = fmt.Sprintf("%d", i16.Value(pos))
-			case arrow.INT32:
-				i32 := col.(*array.Int32)
-				v[i] = fmt.Sprintf("%d", i32.Value(pos))
-			case arrow.INT64:
-				i64 := col.(*array.Int64)
-				v[i] = fmt.Sprintf("%d", i64.Value(pos))
-			case arrow.FLOAT16:
-				f16 := col.(*array.Float16)
-				v[i] = f16.Value(pos).String()
-			case arrow.FLOAT32:
-				f32 := col.(*array.Float32)
-				v[i] = fmt.Sprintf("%.2f", f32.Value(pos))
-			case arrow.FLOAT64:
-				f64 := col.(*array.Float64)
-				v[i] = fmt.Sprintf("%.2f", f64.Value(pos))
-			case arrow.INTERVAL_MONTHS:
-				intV := col.(*array.DayTimeInterval)
-				v[i] = fmt.Sprintf("%v", intV.Value(pos))
-			case arrow.INTERVAL_DAY_TIME:
-				intV := col.(*array.DayTimeInterval)
-				v[i] = fmt.Sprintf("%v", intV.Value(pos))
-			case arrow.TIMESTAMP:
-				ts := col.(*array.Timestamp)
-				v[i] = ts.Value(pos).ToTime(arrow.Nanosecond).String()
+// showArrowTable opens arrowTable in a new tab, showing its first batch of
+// rows. Further batches, followed by the tables of the remaining files, are
+// read on demand as the user scrolls down. source records where the table
+// was read from.
+func (t *DataBrowser) showArrowTable(arrowTable arrow.Table, name, source string, files []string, load func(fileID string) (arrow.Table, error)) *Data {
+	dt := new(Data)
+	*dt = t.newArrowData(arrowTable, source, files, load)
+	t.CreateDataBrowser(dt, name)
+	return dt
+}
+
+// newArrowData prepares arrowTable for display and converts its first batch
+// of rows.
+func (t *DataBrowser) newArrowData(arrowTable arrow.Table, source string, files []string, load func(fileID string) (arrow.Table, error)) Data {
+	var data Data
+	data.source = source
+	data.format = currentNumberFormat()
+	data.location = currentLocation()
+	data.keyOnly = len(data.keyColumns()) > 0
+	data.arrow_table = arrowTable
+	var header []string = make([]string, data.arrow_table.NumCols())
+	for i, f := range data.arrow_table.Schema().Fields() {
+		header[i] = f.Name
+	}
+
+	data.data = make([][]string, 0)
+	data.header = header
+	data.fields = data.arrow_table.Schema().Fields()
+	data.pager = &rowPager{
+		table:  arrowTable,
+		reader: array.NewTableReader(arrowTable, pageSize),
+		files:  files,
+		load:   load,
+	}
+
+	rec, err := data.pager.next()
+	if err != nil {
+		t.showError(err)
+	}
+	if rec != nil {
+		data.appendRecord(rec)
+		rec.Release()
+	}
+	return data
+}
+
+// appendRecord converts the rows of rec to display strings and appends them.
+// Columns are matched to the header by name, so batches read from files with
+// a different column order still line up; missing columns are null and new
+// ones are added to the table. It describes any such schema evolution.
+func (d *Data) appendRecord(rec arrow.Record) string {
+	evolution := d.unionSchema(rec.Schema())
+	pos := columnPositions(d.header)
+	for row := 0; row < int(rec.NumRows()); row++ {
+		var v []string = make([]string, len(d.header))
+		var nulls []bool = make([]bool, len(d.header))
+		for i := range nulls {
+			nulls[i] = true
+		}
+		for c, col := range rec.Columns() {
+			i, ok := pos[rec.ColumnName(c)]
+			if !ok {
+				continue
 			}
+			nulls[i] = col.IsNull(row)
+			v[i] = formatValue(col, row, d.format, d.location)
+		}
+		d.data = append(d.data, v)
+		d.nulls = append(d.nulls, nulls)
+	}
+	d.quality = nil
+	d.totals = nil
+	d.infos = nil
+	d.duplicates = nil
+	for col := range d.heatmaps {
+		d.heatmaps[col] = nil
+	}
+	return evolution
+}
+
+// formatValue renders the value at pos of col for display, writing decimal
+// numbers in format nf and timestamps with a time zone in loc.
+func formatValue(col arrow.Array, pos int, nf numberFormat, loc *time.Location) string {
+	switch col.DataType().ID() {
+	case arrow.STRUCT:
+		s := col.(*array.Struct)
+
+		b, err := s.MarshalJSON()
+		if err != nil {
+			log.Fatal(err)
+		}
+		return string(b)
+
+	case arrow.LIST:
+		as := array.NewSlice(col, int64(pos), int64(pos+1))
+		str := fmt.Sprintf("%v", as)
+		if len(str) > 253 {
+			return str[1:253] + "..."
 		}
-		t.Data[dp].data = append(t.Data[dp].data, v)
+		return str
+	case arrow.STRING:
+		s := col.(*array.String)
+		return s.Value(pos)
+	case arrow.BINARY:
+		b := col.(*array.Binary)
+		return "0x" + hex.EncodeToString(b.Value(pos))
+	case arrow.BOOL:
+		b := col.(*array.Boolean)
+		return fmt.Sprintf("%v", b.Value(pos))
+	case arrow.DATE32:
+		d32 := col.(*array.Date32)
+		return d32.Value(pos).ToTime().String()
+	case arrow.DATE64:
+		d64 := col.(*array.Date64)
+		return d64.Value(pos).ToTime().String()
+	case arrow.DECIMAL:
+		d128 := col.(*array.Decimal128)
+		return nf.localize(d128.Value(pos).ToString(d128.DataType().(*arrow.Decimal128Type).Scale))
+	case arrow.INT8:
+		i8 := col.(*array.Int8)
+		return fmt.Sprintf("%d", i8.Value(pos))
+	case arrow.INT16:
+		i16 := col.(*array.Int16)
+		return fmt.Sprintf("%d", i16.Value(pos))
+	case arrow.INT32:
+		i32 := col.(*array.Int32)
+		return fmt.Sprintf("%d", i32.Value(pos))
+	case arrow.INT64:
+		i64 := col.(*array.Int64)
+		return fmt.Sprintf("%d", i64.Value(pos))
+	case arrow.FLOAT16:
+		f16 := col.(*array.Float16)
+		return f16.Value(pos).String()
+	case arrow.FLOAT32:
+		f32 := col.(*array.Float32)
+		return nf.float(float32Value(f32.Value(pos)), -1)
+	case arrow.FLOAT64:
+		f64 := col.(*array.Float64)
+		return nf.float(f64.Value(pos), -1)
+	case arrow.INTERVAL_MONTHS:
+		intV := col.(*array.MonthInterval)
+		return fmt.Sprintf("%d months", intV.Value(pos))
+	case arrow.INTERVAL_DAY_TIME:
+		intV := col.(*array.DayTimeInterval)
+		return fmt.Sprintf("%v", intV.Value(pos))
+	case arrow.TIMESTAMP:
+		ts := col.(*array.Timestamp)
+		return formatTimestamp(ts.Value(pos), ts.DataType().(*arrow.TimestampType), loc)
+	case arrow.DURATION:
+		d := col.(*array.Duration)
+		unit := d.DataType().(*arrow.DurationType).Unit
+		return (time.Duration(d.Value(pos)) * unit.Multiplier()).String()
+	case arrow.INTERVAL_MONTH_DAY_NANO:
+		v := col.(*array.MonthDayNanoInterval).Value(pos)
+		return fmt.Sprintf("%d months %d days %v", v.Months, v.Days, time.Duration(v.Nanoseconds))
+	}
+	return ""
+}
+
+// currentTable returns the table of the selected browser tab.
+func (t *DataBrowser) currentTable() (*widget.Table, *Data) {
+	if t.innerTabs == nil || t.innerTabs.Selected() == nil {
+		return nil, nil
+	}
+	table := t.tabTables[t.innerTabs.Selected()]
+	return table, t.tables[table]
+}
+
+// GoToColumn asks for a column of the current table and scrolls it into
+// view, selecting a cell in it so it stands out.
+func (t *DataBrowser) GoToColumn() {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
 	}
-	t.Data[dp].arrow_rec.Release()
+	t.mu.Lock()
+	header := slices.Clone(dataItem.header)
+	t.mu.Unlock()
+	columns := widget.NewSelectEntry(header)
+	columns.SetPlaceHolder("Column name")
+	dialog.NewForm("Go to Column", "Go", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("Column", columns),
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		for col, name := range header {
+			if strings.EqualFold(name, columns.Text) {
+				id := widget.TableCellID{Row: t.selection[table].Row, Col: col}
+				table.ScrollTo(id)
+				table.Select(id)
+				return
+			}
+		}
+		t.showError(fmt.Errorf("no column named %q", columns.Text))
+	}, t.w).Show()
+}
 
-	t.Data[dp].arrow_table.Release()
-	return &t.Data[dp]
+// qualifiedName joins the parts of a table path into share.schema.table.
+func qualifiedName(parts ...string) string {
+	return strings.Join(parts, ".")
 }
 
 func (d *DataBrowser) test(t arrow.Table) (arrow.Table, error) {
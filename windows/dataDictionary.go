@@ -0,0 +1,76 @@
+package windows
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+)
+
+// fieldComment returns the comment of column col from its field metadata,
+// or its other metadata as key=value pairs when it has no comment.
+func (d *Data) fieldComment(col int) string {
+	if col >= len(d.fields) {
+		return ""
+	}
+	md := d.fields[col].Metadata
+	if i := md.FindKey("comment"); i >= 0 {
+		return md.Values()[i]
+	}
+	var pairs []string
+	for i, k := range md.Keys() {
+		pairs = append(pairs, k+"="+md.Values()[i])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// markdownCell escapes v for a cell of a Markdown table.
+func markdownCell(v string) string {
+	v = strings.ReplaceAll(v, "|", `\|`)
+	return strings.ReplaceAll(v, "\n", " ")
+}
+
+// writeDataDictionary writes a Markdown document describing every column of
+// dataItem with its schema and the profile of its loaded values.
+func writeDataDictionary(w io.Writer, dataItem *Data) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# %s\n\n", dataItem.source)
+	fmt.Fprintf(bw, "Generated %s. Statistics are computed from the %d loaded rows.\n\n",
+		time.Now().Format(time.DateTime), len(dataItem.data))
+	fmt.Fprintln(bw, "| Column | Type | Nullable | Nulls | Distinct | Min | Max | Comment |")
+	fmt.Fprintln(bw, "|---|---|---|---:|---:|---|---|---|")
+	for col, name := range dataItem.header {
+		info := dataItem.columnInfo(col)
+		fmt.Fprintf(bw, "| %s | %s | %s | %.1f%% | %d | %s | %s | %s |\n",
+			markdownCell(name), markdownCell(info.typeName), info.nullable, info.nullPct, info.distinct,
+			markdownCell(info.min), markdownCell(info.max), markdownCell(dataItem.fieldComment(col)))
+	}
+	return bw.Flush()
+}
+
+// GenerateDataDictionary saves a Markdown data dictionary of the current
+// table.
+func (t *DataBrowser) GenerateDataDictionary() {
+	_, dataItem := t.currentTable()
+	if dataItem == nil {
+		return
+	}
+	d := dialog.NewFileSave(func(uc fyne.URIWriteCloser, err error) {
+		if err != nil || uc == nil {
+			return
+		}
+		defer uc.Close()
+		t.mu.Lock()
+		err = writeDataDictionary(uc, dataItem)
+		t.mu.Unlock()
+		if err != nil {
+			t.showError(err)
+		}
+	}, t.w)
+	d.SetFileName("data_dictionary.md")
+	d.Show()
+}
@@ -0,0 +1,207 @@
+package windows
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// deltaLogDir is the directory of a Delta table holding its transaction log.
+const deltaLogDir = "_delta_log"
+
+// unsupportedDeltaFeatures are reader features that change which rows or
+// columns a data file holds, so reading the files as they are would be wrong.
+var unsupportedDeltaFeatures = []string{"deletionVectors", "columnMapping"}
+
+// columnMappingModeKey is the table property that turns on column mapping.
+// Tables written for reader version 2 enable it only through this property,
+// not through a reader feature.
+const columnMappingModeKey = "delta.columnMapping.mode"
+
+// deltaAction is a line of a Delta log commit file. Only the actions needed
+// to find the data files of the latest version are read.
+type deltaAction struct {
+	Add *struct {
+		Path            string            `json:"path"`
+		PartitionValues map[string]string `json:"partitionValues"`
+		DeletionVector  json.RawMessage   `json:"deletionVector"`
+	} `json:"add"`
+	Remove *struct {
+		Path string `json:"path"`
+	} `json:"remove"`
+	Protocol *struct {
+		ReaderFeatures []string `json:"readerFeatures"`
+	} `json:"protocol"`
+	MetaData *struct {
+		Configuration map[string]string `json:"configuration"`
+	} `json:"metaData"`
+}
+
+// deltaFile is a data file of the latest version of a Delta table.
+type deltaFile struct {
+	path       string
+	partitions []partitionValue
+}
+
+// isDeltaTable reports whether dir holds a Delta transaction log.
+func isDeltaTable(dir string) bool {
+	info, err := os.Stat(filepath.Join(dir, deltaLogDir))
+	return err == nil && info.IsDir()
+}
+
+// deltaFiles replays the JSON commits of the Delta log of dir and returns
+// the data files of the latest version in log order. Checkpoints are not
+// read, so the log must still hold every commit from version 0.
+func deltaFiles(dir string) ([]deltaFile, error) {
+	commits, err := filepath.Glob(filepath.Join(dir, deltaLogDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(commits)
+	if len(commits) == 0 || filepath.Base(commits[0]) != fmt.Sprintf("%020d.json", 0) {
+		return nil, fmt.Errorf("the Delta log of %s does not start at version 0, reading checkpoints is not supported", dir)
+	}
+
+	files := make(map[string]deltaFile)
+	var order []string
+	mappingMode := ""
+	for _, commit := range commits {
+		f, err := os.Open(commit)
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, 16*1024*1024)
+		for scanner.Scan() {
+			var action deltaAction
+			if err := json.Unmarshal(scanner.Bytes(), &action); err != nil {
+				f.Close()
+				return nil, fmt.Errorf("%s: %w", filepath.Base(commit), err)
+			}
+			switch {
+			case action.Protocol != nil:
+				for _, feature := range action.Protocol.ReaderFeatures {
+					for _, unsupported := range unsupportedDeltaFeatures {
+						if feature == unsupported {
+							f.Close()
+							return nil, fmt.Errorf("the Delta table uses %s, which is not supported", feature)
+						}
+					}
+				}
+			case action.MetaData != nil:
+				mappingMode = action.MetaData.Configuration[columnMappingModeKey]
+			case action.Add != nil:
+				if len(action.Add.DeletionVector) > 0 && string(action.Add.DeletionVector) != "null" {
+					f.Close()
+					return nil, fmt.Errorf("%s has a deletion vector, which is not supported", action.Add.Path)
+				}
+				path, err := url.PathUnescape(action.Add.Path)
+				if err != nil {
+					path = action.Add.Path
+				}
+				if _, ok := files[path]; !ok {
+					order = append(order, path)
+				}
+				file := deltaFile{path: filepath.Join(dir, filepath.FromSlash(path))}
+				for column, value := range action.Add.PartitionValues {
+					file.partitions = append(file.partitions, partitionValue{column, value})
+				}
+				sort.Slice(file.partitions, func(i, j int) bool {
+					return file.partitions[i].column < file.partitions[j].column
+				})
+				files[path] = file
+			case action.Remove != nil:
+				path, err := url.PathUnescape(action.Remove.Path)
+				if err != nil {
+					path = action.Remove.Path
+				}
+				delete(files, path)
+			}
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(commit), err)
+		}
+	}
+	if mappingMode != "" && mappingMode != "none" {
+		return nil, fmt.Errorf("the Delta table uses column mapping mode %s, which is not supported", mappingMode)
+	}
+
+	var latest []deltaFile
+	for _, path := range order {
+		if file, ok := files[path]; ok {
+			latest = append(latest, file)
+			delete(files, path)
+		}
+	}
+	return latest, nil
+}
+
+// readDeltaFolder reads the rows of the first data file of the Delta table
+// in dir; the other files are read as the user scrolls down.
+func (t *DataBrowser) readDeltaFolder(dir string) (Data, error) {
+	files, err := deltaFiles(dir)
+	if err != nil {
+		return Data{}, err
+	}
+	if len(files) == 0 {
+		return Data{}, fmt.Errorf("the latest version of the Delta table in %s has no data files", dir)
+	}
+	partitions := make(map[string][]partitionValue, len(files))
+	paths := make([]string, len(files))
+	for i, f := range files {
+		paths[i] = f.path
+		partitions[f.path] = f.partitions
+	}
+	load := func(path string) (arrow.Table, error) {
+		table, err := readParquetFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return withPartitionColumns(table, partitions[path]), nil
+	}
+	first, err := load(paths[0])
+	if err != nil {
+		return Data{}, err
+	}
+	return t.newArrowData(first, dir, paths[1:], load), nil
+}
+
+// loadDeltaFolder shows the latest version of the Delta table in dir.
+func (t *DataBrowser) loadDeltaFolder(dir string) error {
+	data, err := t.readDeltaFolder(dir)
+	if err != nil {
+		return err
+	}
+	data.watch = &fileWatch{path: dir, reload: func() (Data, error) {
+		return t.readDeltaFolder(dir)
+	}}
+	t.CreateDataBrowser(&data, filepath.Base(dir))
+	return nil
+}
+
+// OpenDeltaFolder asks for the directory of a local Delta table and shows
+// its latest version without a sharing server.
+func (t *DataBrowser) OpenDeltaFolder() {
+	dialog.NewFolderOpen(func(lu fyne.ListableURI, err error) {
+		if err != nil || lu == nil {
+			return
+		}
+		if !isDeltaTable(lu.Path()) {
+			t.showError(fmt.Errorf("%s has no %s directory, it is not a Delta table", lu.Path(), deltaLogDir))
+			return
+		}
+		if err := t.loadDeltaFolder(lu.Path()); err != nil {
+			t.showError(err)
+		}
+	}, t.w).Show()
+}
@@ -0,0 +1,125 @@
+package windows
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// isDuplicate reports whether the key of row, made of the values of the
+// duplicate key columns, is shared with another loaded row. The duplicates
+// are found on first use and again after more rows are loaded.
+func (d *Data) isDuplicate(row int) bool {
+	if len(d.dupColumns) == 0 {
+		return false
+	}
+	if d.duplicates == nil {
+		d.duplicates = findDuplicates(d, d.dupColumns)
+	}
+	return d.duplicates[row]
+}
+
+// findDuplicates returns the rows of d whose values in cols are the same as
+// those of at least one other row. Without key columns no row is a
+// duplicate.
+func findDuplicates(d *Data, cols []int) map[int]bool {
+	duplicates := make(map[int]bool)
+	if len(cols) == 0 {
+		return duplicates
+	}
+	groups := make(map[string][]int)
+	for row := range d.data {
+		key := make([]string, len(cols))
+		for i, col := range cols {
+			key[i] = d.cellValue(row, col)
+			if d.isNull(row, col) {
+				key[i] = "\x00"
+			}
+		}
+		k := strings.Join(key, "\x1f")
+		groups[k] = append(groups[k], row)
+	}
+	for _, rows := range groups {
+		if len(rows) > 1 {
+			for _, row := range rows {
+				duplicates[row] = true
+			}
+		}
+	}
+	return duplicates
+}
+
+// HighlightDuplicates asks for the key columns of the current table and
+// highlights the rows that share their key with another row. The key
+// columns saved for the table are chosen by default. The duplicate rows can
+// also be opened in a tab of their own.
+func (t *DataBrowser) HighlightDuplicates() {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
+	}
+	t.mu.Lock()
+	header := slices.Clone(dataItem.header)
+	var selected []string
+	keys := dataItem.keyColumns()
+	for col, name := range header {
+		if len(dataItem.dupColumns) > 0 && slices.Contains(dataItem.dupColumns, col) || len(dataItem.dupColumns) == 0 && keys[name] {
+			selected = append(selected, name)
+		}
+	}
+	t.mu.Unlock()
+	columns := widget.NewCheckGroup(header, nil)
+	columns.SetSelected(selected)
+	onlyDuplicates := widget.NewCheck("Open duplicate rows in a new tab", nil)
+
+	d := dialog.NewCustomConfirm("Highlight Duplicates", "Highlight", "Cancel",
+		container.NewBorder(nil, onlyDuplicates, nil, nil, container.NewVScroll(columns)), func(ok bool) {
+			if !ok {
+				return
+			}
+			t.mu.Lock()
+			dataItem.dupColumns = nil
+			for col, name := range dataItem.header {
+				for _, s := range columns.Selected {
+					if s == name {
+						dataItem.dupColumns = append(dataItem.dupColumns, col)
+					}
+				}
+			}
+			dataItem.duplicates = nil
+			var rows []int
+			for row := range dataItem.data {
+				if dataItem.isDuplicate(row) {
+					rows = append(rows, row)
+				}
+			}
+			keyed := len(dataItem.dupColumns) > 0
+			loaded := len(dataItem.data)
+			dup := Data{header: dataItem.header, fields: dataItem.fields, source: dataItem.source, profile: dataItem.profile, format: dataItem.format}
+			if onlyDuplicates.Checked {
+				for _, row := range rows {
+					dup.data = append(dup.data, dataItem.data[row])
+					if dataItem.nulls != nil {
+						dup.nulls = append(dup.nulls, dataItem.nulls[row])
+					}
+				}
+			}
+			t.mu.Unlock()
+			table.Refresh()
+			if !keyed {
+				t.setStatus("")
+				return
+			}
+			t.setStatus(fmt.Sprintf("%d of %d loaded rows have a duplicate key", len(rows), loaded))
+			if len(dup.data) > 0 {
+				t.CreateDataBrowser(&dup, t.innerTabs.Selected().Text+" duplicates")
+			}
+		}, t.w)
+	d.Resize(fyne.NewSize(300, 400))
+	d.Show()
+}
@@ -0,0 +1,45 @@
+package windows
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	data := &Data{
+		header: []string{"id", "name"},
+		data: [][]string{
+			{"1", "a"},
+			{"2", "a"},
+			{"1", "a"},
+			{"", "b"},
+			{"", "b"},
+			{"", "c"},
+		},
+		nulls: [][]bool{
+			{false, false},
+			{false, false},
+			{false, false},
+			{true, false},
+			{false, false},
+			{true, false},
+		},
+	}
+	tests := []struct {
+		name string
+		cols []int
+		want map[int]bool
+	}{
+		{"one column", []int{1}, map[int]bool{0: true, 1: true, 2: true, 3: true, 4: true}},
+		{"two columns", []int{0, 1}, map[int]bool{0: true, 2: true}},
+		{"null differs from empty", []int{0}, map[int]bool{0: true, 2: true, 3: true, 5: true}},
+		{"no columns", nil, map[int]bool{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findDuplicates(data, tt.cols); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findDuplicates(%v) = %v, want %v", tt.cols, got, tt.want)
+			}
+		})
+	}
+}
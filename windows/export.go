@@ -0,0 +1,412 @@
+package windows
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	exportMetadataPreference  = "exportMetadata"
+	exportNullTokenPreference = "exportNullToken"
+)
+
+// exportMetadata is written next to an exported file to record what it
+// contains and where it came from.
+type exportMetadata struct {
+	Source     string    `json:"source"`
+	Columns    []string  `json:"columns"`
+	Rows       int       `json:"rows"`
+	Sample     int       `json:"sample,omitempty"`
+	Seed       int64     `json:"seed,omitempty"`
+	NullToken  string    `json:"nullToken,omitempty"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+// writeExportMetadata writes <name>.meta.json beside the exported file uri.
+func writeExportMetadata(uri fyne.URI, data Data, opts exportOptions) error {
+	parent, err := storage.Parent(uri)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSuffix(uri.Name(), uri.Extension()) + ".meta.json"
+	metaURI, err := storage.Child(parent, name)
+	if err != nil {
+		return err
+	}
+	w, err := storage.Writer(metaURI)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(exportMetadata{
+		Source:     data.source,
+		Columns:    data.header,
+		Rows:       len(data.data),
+		Sample:     opts.sample,
+		Seed:       opts.seed,
+		NullToken:  opts.nullToken,
+		ExportedAt: time.Now(),
+	})
+}
+
+// ExportToCSV writes the header and rows of data as delimited values. The
+// delimiter and the token written for null cells are taken from opts.
+func ExportToCSV(w io.Writer, data Data, opts exportOptions) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = opts.format.csvComma()
+	if err := cw.Write(data.header); err != nil {
+		return err
+	}
+	for i, row := range data.data {
+		if i%exportCheckRows == 0 && opts.cancelled() {
+			return errExportCancelled
+		}
+		if opts.nullToken != "" && data.nulls != nil {
+			out := make([]string, len(row))
+			for j, v := range row {
+				if data.nulls[i][j] {
+					v = opts.nullToken
+				}
+				out[j] = v
+			}
+			row = out
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// combineData concatenates the rows of items into one table. Unless
+// commonOnly is set every item must have the same columns with the same
+// Arrow types; otherwise only the columns present in all items are kept.
+// Values are taken as displayed unless fullPrecision is set.
+func combineData(items []*Data, names []string, commonOnly, fullPrecision bool) (Data, error) {
+	first := items[0]
+	header := first.header
+	if commonOnly {
+		header = commonColumns(items)
+		if len(header) == 0 {
+			return Data{}, fmt.Errorf("the selected tabs have no columns in common")
+		}
+	} else {
+		var problems []string
+		for i, item := range items[1:] {
+			if p := schemaMismatch(first, item); p != "" {
+				problems = append(problems, fmt.Sprintf("%s: %s", names[i+1], p))
+			}
+		}
+		if len(problems) > 0 {
+			return Data{}, fmt.Errorf("schemas of the selected tabs do not match %s:\n%s",
+				names[0], strings.Join(problems, "\n"))
+		}
+	}
+
+	combined := Data{header: header, data: make([][]string, 0), nulls: make([][]bool, 0), format: first.format}
+	if len(first.fields) == len(first.header) {
+		firstPos := columnPositions(first.header)
+		for _, name := range header {
+			combined.fields = append(combined.fields, first.fields[firstPos[name]])
+		}
+	}
+	for _, item := range items {
+		pos := columnPositions(item.header)
+		for r := range item.data {
+			v := make([]string, len(header))
+			nulls := make([]bool, len(header))
+			for i, name := range header {
+				v[i] = item.exportValue(r, pos[name], fullPrecision)
+				nulls[i] = item.isNull(r, pos[name])
+			}
+			combined.data = append(combined.data, v)
+			combined.nulls = append(combined.nulls, nulls)
+		}
+	}
+	return combined, nil
+}
+
+func columnPositions(header []string) map[string]int {
+	pos := make(map[string]int, len(header))
+	for i, name := range header {
+		pos[name] = i
+	}
+	return pos
+}
+
+func commonColumns(items []*Data) []string {
+	var common []string
+	for _, name := range items[0].header {
+		inAll := true
+		for _, item := range items[1:] {
+			if _, ok := columnPositions(item.header)[name]; !ok {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			common = append(common, name)
+		}
+	}
+	return common
+}
+
+// schemaMismatch describes how the columns of other differ from want, or
+// returns an empty string when they are compatible.
+func schemaMismatch(want, other *Data) string {
+	var missing, extra, types []string
+	otherPos := columnPositions(other.header)
+	for i, name := range want.header {
+		j, ok := otherPos[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		if i < len(want.fields) && j < len(other.fields) &&
+			want.fields[i].Type.String() != other.fields[j].Type.String() {
+			types = append(types, fmt.Sprintf("%s (%s vs %s)", name, want.fields[i].Type, other.fields[j].Type))
+		}
+	}
+	wantPos := columnPositions(want.header)
+	for _, name := range other.header {
+		if _, ok := wantPos[name]; !ok {
+			extra = append(extra, name)
+		}
+	}
+
+	var parts []string
+	if len(missing) > 0 {
+		parts = append(parts, "missing columns "+strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		parts = append(parts, "extra columns "+strings.Join(extra, ", "))
+	}
+	if len(types) > 0 {
+		parts = append(parts, "different types "+strings.Join(types, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ExportTabs lets the user pick several open tabs and writes their rows to
+// a single file in each chosen format.
+func (t *DataBrowser) ExportTabs() {
+	labels := make([]string, len(t.tabs))
+	tabs := make(map[string]*container.TabItem, len(t.tabs))
+	for i, ti := range t.tabs {
+		labels[i] = fmt.Sprintf("%d. %s", i+1, ti.Text)
+		tabs[labels[i]] = ti
+	}
+	selection := widget.NewCheckGroup(labels, nil)
+	formats := widget.NewCheckGroup(exportFormatNames(), nil)
+	formats.Horizontal = true
+	formats.SetSelected([]string{exportFormats[0].name})
+	keepOrder := widget.NewCheck("Keep column order in JSON", nil)
+	keepOrder.Checked = true
+	rawTypes := widget.NewCheck("Write JSON numbers and booleans unquoted", nil)
+	rawTypes.Checked = true
+	utcTimes := widget.NewCheck("Write timestamps in UTC", func(b bool) {
+		fyne.CurrentApp().Preferences().SetBool(exportUTCPreference, b)
+	})
+	utcTimes.Checked = fyne.CurrentApp().Preferences().BoolWithFallback(exportUTCPreference, true)
+	commonOnly := widget.NewCheck("Only export columns common to all tabs", nil)
+	chooseColumns := widget.NewCheck("Choose the columns to export", nil)
+	mapNames := widget.NewCheck("Rename columns in the export", nil)
+	writeMeta := widget.NewCheck("Write .meta.json sidecar", func(b bool) {
+		fyne.CurrentApp().Preferences().SetBool(exportMetadataPreference, b)
+	})
+	writeMeta.Checked = fyne.CurrentApp().Preferences().Bool(exportMetadataPreference)
+	sample := widget.NewEntry()
+	sample.SetPlaceHolder("all rows")
+	seed := widget.NewEntry()
+	seed.SetText("1")
+	delimiter := widget.NewSelect(csvDelimiterNames(), nil)
+	delimiter.Selected = currentCSVDelimiter()
+	nullToken := widget.NewSelectEntry([]string{`\N`, "NULL"})
+	nullToken.SetPlaceHolder("empty")
+	nullToken.SetText(fyne.CurrentApp().Preferences().String(exportNullTokenPreference))
+
+	d := dialog.NewForm("Export Tabs", "Export", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("Tabs", selection),
+		widget.NewFormItem("Formats", formats),
+		{Text: "CSV delimiter", Widget: delimiter, HintText: "Default set in the settings"},
+		widget.NewFormItem("", keepOrder),
+		widget.NewFormItem("", rawTypes),
+		widget.NewFormItem("", utcTimes),
+		widget.NewFormItem("", commonOnly),
+		{Text: "", Widget: chooseColumns, HintText: "Otherwise the columns visible in the grid are exported"},
+		widget.NewFormItem("", mapNames),
+		widget.NewFormItem("", writeMeta),
+		{Text: "Sample rows", Widget: sample, HintText: "Uniform random sample of the exported rows"},
+		widget.NewFormItem("Seed", seed),
+		{Text: "Null as", Widget: nullToken, HintText: "Written for null cells, empty by default"},
+	}, func(ok bool) {
+		if !ok || len(selection.Selected) == 0 || len(formats.Selected) == 0 {
+			return
+		}
+		fyne.CurrentApp().Preferences().SetString(exportNullTokenPreference, nullToken.Text)
+		opts := exportOptions{
+			writeMeta: writeMeta.Checked,
+			nullToken: nullToken.Text,
+			format:    currentNumberFormat().withCSVDelimiter(delimiter.Selected),
+			sortKeys:  !keepOrder.Checked,
+			rawTypes:  rawTypes.Checked,
+			utcTimes:  utcTimes.Checked,
+		}
+		if sample.Text != "" {
+			n, err := strconv.Atoi(sample.Text)
+			if err != nil || n < 1 {
+				t.showError(fmt.Errorf("sample rows must be a positive number, got %q", sample.Text))
+				return
+			}
+			opts.sample = n
+			if opts.seed, err = strconv.ParseInt(seed.Text, 10, 64); err != nil {
+				t.showError(fmt.Errorf("seed must be a number, got %q", seed.Text))
+				return
+			}
+		}
+		var items []*Data
+		var sources []string
+		for _, label := range selection.Selected {
+			// The tab itself is looked up, as tabs may have been closed or
+			// moved since the dialog was opened.
+			item := t.tables[t.tabTables[tabs[label]]]
+			if item == nil {
+				t.showError(fmt.Errorf("tab %s was closed", label))
+				return
+			}
+			items = append(items, item)
+			sources = append(sources, item.source)
+		}
+		t.mu.Lock()
+		combined, err := combineData(items, selection.Selected, commonOnly.Checked, exportFullPrecision())
+		visible := visibleColumns(combined, items)
+		t.mu.Unlock()
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		combined.source = strings.Join(sources, ", ")
+		export := func(combined Data) {
+			if opts.sample > 0 {
+				combined = sampleRows(combined, opts.sample, opts.seed)
+			}
+			if opts.utcTimes {
+				combined = timestampsToUTC(combined)
+			}
+			if len(formats.Selected) == 1 {
+				t.saveExport(combined, opts, exportFormatByName(formats.Selected[0]))
+				return
+			}
+			var chosen []exportFormat
+			for _, name := range formats.Selected {
+				chosen = append(chosen, exportFormatByName(name))
+			}
+			t.saveExports(combined, opts, chosen)
+		}
+		rename := func(combined Data) {
+			if mapNames.Checked {
+				t.chooseColumnNames(combined, export)
+				return
+			}
+			export(combined)
+		}
+		if chooseColumns.Checked {
+			t.chooseExportColumns(combined, visible, rename)
+			return
+		}
+		rename(selectColumns(combined, visible))
+	}, t.w)
+	d.Resize(fyne.NewSize(400, 0))
+	d.Show()
+}
+
+// exportOptions holds the choices made in an export dialog.
+type exportOptions struct {
+	writeMeta bool
+	// sample is the size of a uniform random sample of the exported rows,
+	// or 0 to export all of them.
+	sample int
+	seed   int64
+	// nullToken is written for null cells instead of an empty value.
+	nullToken string
+	// format sets the CSV delimiter.
+	format numberFormat
+	// sortKeys writes JSON object keys in alphabetical instead of column
+	// order.
+	sortKeys bool
+	// rawTypes writes numbers and booleans as JSON values instead of
+	// strings.
+	rawTypes bool
+	// utcTimes writes timestamps in UTC whatever zone they are shown in.
+	utcTimes bool
+	// cancel is closed when the user cancels the export.
+	cancel <-chan struct{}
+}
+
+// sampleRows picks n rows of data uniformly at random, keeping their order.
+// The same seed always picks the same rows.
+func sampleRows(data Data, n int, seed int64) Data {
+	if n >= len(data.data) {
+		return data
+	}
+	picked := rand.New(rand.NewSource(seed)).Perm(len(data.data))[:n]
+	sort.Ints(picked)
+	rows := make([][]string, n)
+	var nulls [][]bool
+	if data.nulls != nil {
+		nulls = make([][]bool, n)
+	}
+	for i, row := range picked {
+		rows[i] = data.data[row]
+		if nulls != nil {
+			nulls[i] = data.nulls[row]
+		}
+	}
+	data.data, data.nulls = rows, nulls
+	return data
+}
+
+func (t *DataBrowser) saveExport(data Data, opts exportOptions, f exportFormat) {
+	d := dialog.NewFileSave(func(uc fyne.URIWriteCloser, err error) {
+		if err != nil || uc == nil {
+			return
+		}
+		t.runExport(opts, func(opts exportOptions) {
+			err := f.write(uc, data, opts)
+			uc.Close()
+			if errors.Is(err, errExportCancelled) {
+				storage.Delete(uc.URI())
+				t.setStatus("Export cancelled")
+				return
+			}
+			if err != nil {
+				t.showError(err)
+				return
+			}
+			if opts.writeMeta {
+				if err := writeExportMetadata(uc.URI(), data, opts); err != nil {
+					t.showError(err)
+				}
+			}
+		})
+	}, t.w)
+	d.SetFileName("export" + f.extension)
+	d.Show()
+}
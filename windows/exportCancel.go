@@ -0,0 +1,50 @@
+package windows
+
+import (
+	"errors"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// exportCheckRows is how many rows the export writers write between checks
+// for cancellation.
+const exportCheckRows = 1000
+
+var errExportCancelled = errors.New("export cancelled")
+
+// cancelled reports whether the user cancelled the export. Options without
+// a cancel channel are never cancelled.
+func (o exportOptions) cancelled() bool {
+	select {
+	case <-o.cancel:
+		return true
+	default:
+		return false
+	}
+}
+
+// runExport calls write in the background with a progress dialog whose
+// Cancel button makes the writers stop with errExportCancelled.
+func (t *DataBrowser) runExport(opts exportOptions, write func(opts exportOptions)) {
+	cancel := make(chan struct{})
+	opts.cancel = cancel
+	progress := widget.NewProgressBarInfinite()
+	d := dialog.NewCustomWithoutButtons("Exporting", progress, t.w)
+	var once sync.Once
+	d.SetButtons([]fyne.CanvasObject{
+		widget.NewButton("Cancel", func() {
+			once.Do(func() { close(cancel) })
+		}),
+	})
+	d.Show()
+	go func() {
+		defer func() {
+			progress.Stop()
+			d.Hide()
+		}()
+		write(opts)
+	}()
+}
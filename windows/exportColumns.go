@@ -0,0 +1,93 @@
+package windows
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// isVisible reports whether column name is shown in the grid, which is not
+// the case for the non-key columns of a tab showing key columns only.
+func (d *Data) isVisible(name string) bool {
+	if !d.keyOnly {
+		return true
+	}
+	keys := d.keyColumns()
+	return len(keys) == 0 || keys[name]
+}
+
+// visibleColumns returns the columns of data that are visible in all items.
+func visibleColumns(data Data, items []*Data) []string {
+	var visible []string
+	for _, name := range data.header {
+		shown := true
+		for _, item := range items {
+			shown = shown && item.isVisible(name)
+		}
+		if shown {
+			visible = append(visible, name)
+		}
+	}
+	return visible
+}
+
+// selectColumns returns data with only the named columns, in the order of
+// data.
+func selectColumns(data Data, names []string) Data {
+	keep := make(map[string]bool, len(names))
+	for _, name := range names {
+		keep[name] = true
+	}
+	var cols []int
+	for col, name := range data.header {
+		if keep[name] {
+			cols = append(cols, col)
+		}
+	}
+	if len(cols) == len(data.header) {
+		return data
+	}
+	selected := data
+	selected.header = make([]string, len(cols))
+	selected.data = make([][]string, len(data.data))
+	selected.fields = nil
+	selected.nulls = nil
+	for i, col := range cols {
+		selected.header[i] = data.header[col]
+		if len(data.fields) == len(data.header) {
+			selected.fields = append(selected.fields, data.fields[col])
+		}
+	}
+	for r, row := range data.data {
+		selected.data[r] = make([]string, len(cols))
+		for i, col := range cols {
+			selected.data[r][i] = row[col]
+		}
+	}
+	if data.nulls != nil {
+		selected.nulls = make([][]bool, len(data.nulls))
+		for r := range data.nulls {
+			selected.nulls[r] = make([]bool, len(cols))
+			for i, col := range cols {
+				selected.nulls[r][i] = data.isNull(r, col)
+			}
+		}
+	}
+	return selected
+}
+
+// chooseExportColumns lets the user pick the columns of data to export,
+// starting from those visible in the grid, and passes the result to next.
+func (t *DataBrowser) chooseExportColumns(data Data, visible []string, next func(Data)) {
+	columns := widget.NewCheckGroup(data.header, nil)
+	columns.SetSelected(visible)
+	d := dialog.NewCustomConfirm("Columns to Export", "Export", "Cancel", container.NewVScroll(columns), func(ok bool) {
+		if !ok || len(columns.Selected) == 0 {
+			return
+		}
+		next(selectColumns(data, columns.Selected))
+	}, t.w)
+	d.Resize(fyne.NewSize(300, 400))
+	d.Show()
+}
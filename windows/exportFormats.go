@@ -0,0 +1,188 @@
+package windows
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+)
+
+// exportFormat is a file format tables can be exported to.
+type exportFormat struct {
+	name      string
+	extension string
+	write     func(w io.Writer, data Data, opts exportOptions) error
+}
+
+var exportFormats = []exportFormat{
+	{name: "CSV", extension: ".csv", write: ExportToCSV},
+	{name: "TSV", extension: ".tsv", write: func(w io.Writer, data Data, opts exportOptions) error {
+		opts.format.comma = '\t'
+		return ExportToCSV(w, data, opts)
+	}},
+	{name: "JSON", extension: ".json", write: ExportToJSON},
+}
+
+func exportFormatNames() []string {
+	names := make([]string, len(exportFormats))
+	for i, f := range exportFormats {
+		names[i] = f.name
+	}
+	return names
+}
+
+func exportFormatByName(name string) exportFormat {
+	for _, f := range exportFormats {
+		if f.name == name {
+			return f
+		}
+	}
+	return exportFormats[0]
+}
+
+// ExportToJSON writes the rows of data as a JSON array of objects keyed by
+// column name, in column order unless opts.sortKeys is set. Null cells are
+// written as null; with opts.rawTypes numeric and boolean columns are
+// written as JSON numbers and booleans.
+func ExportToJSON(w io.Writer, data Data, opts exportOptions) error {
+	order := make([]int, len(data.header))
+	for i := range order {
+		order[i] = i
+	}
+	if opts.sortKeys {
+		sort.Slice(order, func(a, b int) bool { return data.header[order[a]] < data.header[order[b]] })
+	}
+	keys := make([][]byte, len(data.header))
+	for i, name := range data.header {
+		keys[i], _ = json.Marshal(name)
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("[")
+	for i := range data.data {
+		if i%exportCheckRows == 0 && opts.cancelled() {
+			return errExportCancelled
+		}
+		if i > 0 {
+			bw.WriteString(",")
+		}
+		bw.WriteString("\n  {")
+		for n, j := range order {
+			if n > 0 {
+				bw.WriteString(", ")
+			}
+			bw.Write(keys[j])
+			bw.WriteString(": ")
+			bw.Write(jsonValue(data, i, j, opts.rawTypes))
+		}
+		bw.WriteString("}")
+	}
+	bw.WriteString("\n]\n")
+	return bw.Flush()
+}
+
+// jsonValue encodes a cell of data for JSON export.
+func jsonValue(data Data, row, col int, rawTypes bool) []byte {
+	if data.nulls != nil && data.nulls[row][col] {
+		return []byte("null")
+	}
+	v := data.data[row][col]
+	if rawTypes && col < len(data.fields) {
+		switch typeCategory(data.fields[col].Type) {
+		case "integer", "decimal":
+			v := data.format.delocalize(v)
+			if _, err := strconv.ParseFloat(v, 64); err == nil && json.Valid([]byte(v)) {
+				return []byte(v)
+			}
+		case "float":
+			if f, err := data.format.parseFloat(v); err == nil {
+				return []byte(strconv.FormatFloat(f, 'f', -1, 64))
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(v); err == nil {
+				return []byte(strconv.FormatBool(b))
+			}
+		}
+	}
+	b, _ := json.Marshal(v)
+	return b
+}
+
+// saveExports asks for a folder and writes data to it once per format,
+// naming every file base plus the format's extension, then reports which
+// files were written and which failed.
+func (t *DataBrowser) saveExports(data Data, opts exportOptions, formats []exportFormat) {
+	base := widget.NewEntry()
+	base.SetText("export")
+	dialog.NewForm("Export As", "Choose Folder...", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("File name", base),
+	}, func(ok bool) {
+		if !ok || base.Text == "" {
+			return
+		}
+		dialog.NewFolderOpen(func(dir fyne.ListableURI, err error) {
+			if err != nil || dir == nil {
+				return
+			}
+			t.runExport(opts, func(opts exportOptions) {
+				var written, failed []string
+				for _, f := range formats {
+					uri, err := t.writeExport(dir, base.Text+f.extension, data, opts, f)
+					if errors.Is(err, errExportCancelled) {
+						status := "Export cancelled"
+						if len(written) > 0 {
+							status += ", already wrote " + strings.Join(written, ", ")
+						}
+						t.setStatus(status)
+						return
+					}
+					if err != nil {
+						failed = append(failed, fmt.Sprintf("%s: %v", f.name, err))
+						continue
+					}
+					written = append(written, uri.Name())
+				}
+				if opts.writeMeta && len(written) > 0 {
+					uri, _ := storage.Child(dir, written[0])
+					if err := writeExportMetadata(uri, data, opts); err != nil {
+						failed = append(failed, fmt.Sprintf("metadata: %v", err))
+					}
+				}
+				summary := fmt.Sprintf("Wrote %s to %s", strings.Join(written, ", "), dir.Path())
+				if len(written) == 0 {
+					summary = "No files were written"
+				}
+				if len(failed) > 0 {
+					summary += "\n\nFailed:\n" + strings.Join(failed, "\n")
+				}
+				dialog.NewInformation("Export", summary, t.w).Show()
+			})
+		}, t.w).Show()
+	}, t.w).Show()
+}
+
+func (t *DataBrowser) writeExport(dir fyne.ListableURI, name string, data Data, opts exportOptions, f exportFormat) (fyne.URI, error) {
+	uri, err := storage.Child(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	w, err := storage.Writer(uri)
+	if err != nil {
+		return nil, err
+	}
+	err = f.write(w, data, opts)
+	w.Close()
+	if errors.Is(err, errExportCancelled) {
+		storage.Delete(uri)
+	}
+	return uri, err
+}
@@ -0,0 +1,41 @@
+package windows
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestExportToJSON(t *testing.T) {
+	european := testData()
+	european.format = numberFormats[1]
+	european.data[0][1] = "1.234,5"
+	tests := []struct {
+		name     string
+		data     Data
+		sortKeys bool
+		rawTypes bool
+		want     string
+	}{
+		{"strings", testData(), false, false,
+			"[\n  {\"name\": \"a,b\", \"amount\": \"1.5\", \"active\": \"true\"},\n  {\"name\": null, \"amount\": null, \"active\": null}\n]\n"},
+		{"raw types", testData(), false, true,
+			"[\n  {\"name\": \"a,b\", \"amount\": 1.5, \"active\": true},\n  {\"name\": null, \"amount\": null, \"active\": null}\n]\n"},
+		{"raw localized types", european, false, true,
+			"[\n  {\"name\": \"a,b\", \"amount\": 1234.5, \"active\": true},\n  {\"name\": null, \"amount\": null, \"active\": null}\n]\n"},
+		{"sorted keys", testData(), true, false,
+			"[\n  {\"active\": \"true\", \"amount\": \"1.5\", \"name\": \"a,b\"},\n  {\"active\": null, \"amount\": null, \"name\": null}\n]\n"},
+		{"no rows", Data{header: []string{"name"}}, false, false, "[\n]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			opts := exportOptions{sortKeys: tt.sortKeys, rawTypes: tt.rawTypes}
+			if err := ExportToJSON(&buf, tt.data, opts); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package windows
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+// renameColumns returns data with its columns named names, in column order.
+// The header and fields of data are copied, so the tables the data was
+// taken from keep their names.
+func renameColumns(data Data, names []string) (Data, error) {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name == "" {
+			return Data{}, fmt.Errorf("column names cannot be empty")
+		}
+		if seen[name] {
+			return Data{}, fmt.Errorf("more than one column would be named %s", name)
+		}
+		seen[name] = true
+	}
+	renamed := data
+	renamed.header = append([]string(nil), names...)
+	if len(data.fields) == len(names) {
+		renamed.fields = append(renamed.fields[:0:0], data.fields...)
+		for i := range renamed.fields {
+			renamed.fields[i].Name = names[i]
+		}
+	}
+	return renamed, nil
+}
+
+// chooseColumnNames lets the user rename the columns of data for an export,
+// starting from their current names, and passes the result to next.
+func (t *DataBrowser) chooseColumnNames(data Data, next func(Data)) {
+	form := container.New(layout.NewFormLayout())
+	entries := make([]*widget.Entry, len(data.header))
+	for i, name := range data.header {
+		entries[i] = widget.NewEntry()
+		entries[i].SetText(name)
+		form.Add(widget.NewLabel(name))
+		form.Add(entries[i])
+	}
+	d := dialog.NewCustomConfirm("Column Names in Export", "Export", "Cancel", container.NewVScroll(form), func(ok bool) {
+		if !ok {
+			return
+		}
+		names := make([]string, len(entries))
+		for i, e := range entries {
+			names[i] = e.Text
+		}
+		renamed, err := renameColumns(data, names)
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		next(renamed)
+	}, t.w)
+	d.Resize(fyne.NewSize(400, 400))
+	d.Show()
+}
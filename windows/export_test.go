@@ -0,0 +1,82 @@
+package windows
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// testData is a small table with a null in each column.
+func testData() Data {
+	return Data{
+		header: []string{"name", "amount", "active"},
+		fields: []arrow.Field{
+			{Name: "name", Type: arrow.BinaryTypes.String},
+			{Name: "amount", Type: arrow.PrimitiveTypes.Float64},
+			{Name: "active", Type: arrow.FixedWidthTypes.Boolean},
+		},
+		data: [][]string{
+			{"a,b", "1.5", "true"},
+			{"", "", ""},
+		},
+		nulls: [][]bool{
+			{false, false, false},
+			{true, true, true},
+		},
+	}
+}
+
+func TestExportToCSV(t *testing.T) {
+	tests := []struct {
+		delimiter string
+		want      string
+	}{
+		{csvDelimiterDefault, "name,amount,active\n\"a,b\",1.5,true\n,,\n"},
+		{"Comma", "name,amount,active\n\"a,b\",1.5,true\n,,\n"},
+		{"Semicolon", "name;amount;active\na,b;1.5;true\n;;\n"},
+		{"Tab", "name\tamount\tactive\na,b\t1.5\ttrue\n\t\t\n"},
+		{"Pipe", "name|amount|active\na,b|1.5|true\n||\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.delimiter, func(t *testing.T) {
+			var buf bytes.Buffer
+			opts := exportOptions{format: numberFormats[0].withCSVDelimiter(tt.delimiter)}
+			if err := ExportToCSV(&buf, testData(), opts); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportToCSVNullToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		nullToken string
+		nulls     bool
+		want      string
+	}{
+		{"empty token", "", true, "name,amount,active\n\"a,b\",1.5,true\n,,\n"},
+		{"token", "NULL", true, "name,amount,active\n\"a,b\",1.5,true\nNULL,NULL,NULL\n"},
+		{"escaped token", "\\N", true, "name,amount,active\n\"a,b\",1.5,true\n\\N,\\N,\\N\n"},
+		{"no null mask", "NULL", false, "name,amount,active\n\"a,b\",1.5,true\n,,\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testData()
+			if !tt.nulls {
+				data.nulls = nil
+			}
+			var buf bytes.Buffer
+			if err := ExportToCSV(&buf, data, exportOptions{nullToken: tt.nullToken}); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package windows
+
+import (
+	"fmt"
+	"sort"
+
+	delta_sharing "github.com/magpierre/go_delta_sharing_client"
+)
+
+// ShowFiles opens a tab listing the files behind the current Delta Sharing
+// table with their size, record count and partition values.
+func (t *DataBrowser) ShowFiles() {
+	_, dataItem := t.currentTable()
+	if dataItem == nil {
+		return
+	}
+	// The files are replaced when the table is refreshed.
+	t.mu.Lock()
+	addFiles := dataItem.addFiles
+	t.mu.Unlock()
+	if addFiles == nil {
+		t.showError(fmt.Errorf("%s was not loaded from a Delta Sharing table", dataItem.source))
+		return
+	}
+	files := filesData(addFiles)
+	files.source = dataItem.source
+	files.profile = dataItem.profile
+	t.CreateDataBrowser(&files, t.innerTabs.Selected().Text+" files")
+}
+
+// filesData describes files as a table with one row per file and one column
+// per partition key.
+func filesData(files []delta_sharing.File) Data {
+	keys := make(map[string]bool)
+	for _, f := range files {
+		for k := range f.PartitionValues {
+			keys[k] = true
+		}
+	}
+	partitions := make([]string, 0, len(keys))
+	for k := range keys {
+		partitions = append(partitions, k)
+	}
+	sort.Strings(partitions)
+
+	data := Data{
+		header: append([]string{"id", "size", "records", "version"}, partitions...),
+		data:   make([][]string, 0, len(files)),
+	}
+	for _, f := range files {
+		records := ""
+		if stats, err := f.GetStats(); err == nil {
+			records = fmt.Sprintf("%d", stats.NumRecords)
+		}
+		row := []string{f.Id, fmt.Sprintf("%.0f", f.Size), records, fmt.Sprintf("%d", f.Version)}
+		for _, k := range partitions {
+			row = append(row, f.PartitionValues[k])
+		}
+		data.data = append(data.data, row)
+	}
+	return data
+}
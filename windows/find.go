@@ -0,0 +1,138 @@
+package windows
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// findBar searches the loaded rows of a table. Matching cells are shown in
+// bold and next/previous select them one by one; unlike a filter all rows
+// stay visible.
+type findBar struct {
+	box   *fyne.Container
+	entry *paletteEntry
+}
+
+// findMatches returns the cells of dataItem containing term, ignoring case,
+// in row order.
+func findMatches(dataItem *Data, term string) []widget.TableCellID {
+	term = strings.ToLower(term)
+	var matches []widget.TableCellID
+	for row, values := range dataItem.data {
+		for col, v := range values {
+			if strings.Contains(strings.ToLower(v), term) {
+				matches = append(matches, widget.TableCellID{Row: row, Col: col})
+			}
+		}
+	}
+	return matches
+}
+
+// nextMatch returns the index of the match step places from the cell last
+// shown, which may have moved or gone since the matches were last found:
+// forward from the first match after it, back from the last match before
+// it. Without a last cell it starts before the first match.
+func nextMatch(matches []widget.TableCellID, last *widget.TableCellID, step int) int {
+	// i is the first match at or after the last cell.
+	i := 0
+	if last != nil {
+		i = sort.Search(len(matches), func(i int) bool {
+			m := matches[i]
+			return m.Row > last.Row || (m.Row == last.Row && m.Col >= last.Col)
+		})
+	}
+	if step > 0 && (last == nil || i == len(matches) || matches[i] != *last) {
+		i--
+	}
+	n := len(matches)
+	return ((i+step)%n + n) % n
+}
+
+// isFindMatch reports whether v contains the term of the find bar.
+func (d *Data) isFindMatch(v string) bool {
+	return d.findTerm != "" && strings.Contains(strings.ToLower(v), strings.ToLower(d.findTerm))
+}
+
+// newFindBar creates the find bar of table. The matches are found again on
+// every step, so rows loaded or reloaded since the term was typed are
+// searched too; the step starts from the cell last shown.
+func (t *DataBrowser) newFindBar(table *widget.Table, dataItem *Data) *findBar {
+	var last *widget.TableCellID
+	count := widget.NewLabel("")
+
+	show := func(step int) {
+		t.mu.Lock()
+		term := dataItem.findTerm
+		var matches []widget.TableCellID
+		if term != "" {
+			matches = findMatches(dataItem, term)
+		}
+		t.mu.Unlock()
+		if len(matches) == 0 {
+			if term != "" {
+				count.SetText("0 matches")
+			}
+			return
+		}
+		current := nextMatch(matches, last, step)
+		last = &matches[current]
+		count.SetText(fmt.Sprintf("%d of %d", current+1, len(matches)))
+		table.ScrollTo(matches[current])
+		table.Select(matches[current])
+	}
+
+	f := &findBar{}
+	hide := func() {
+		t.mu.Lock()
+		dataItem.findTerm = ""
+		t.mu.Unlock()
+		f.entry.SetText("")
+		f.box.Hide()
+		table.Refresh()
+	}
+	f.entry = &paletteEntry{onEscape: hide}
+	f.entry.ExtendBaseWidget(f.entry)
+	f.entry.SetPlaceHolder("Find in table")
+	f.entry.OnChanged = func(s string) {
+		t.mu.Lock()
+		dataItem.findTerm = s
+		matches := 0
+		if s != "" {
+			matches = len(findMatches(dataItem, s))
+		}
+		t.mu.Unlock()
+		last = nil
+		switch {
+		case s == "":
+			count.SetText("")
+		default:
+			count.SetText(fmt.Sprintf("%d matches", matches))
+		}
+		table.Refresh()
+	}
+	f.entry.OnSubmitted = func(string) { show(1) }
+
+	f.box = container.NewBorder(nil, nil, nil, container.NewHBox(count,
+		widget.NewButtonWithIcon("", theme.MoveUpIcon(), func() { show(-1) }),
+		widget.NewButtonWithIcon("", theme.MoveDownIcon(), func() { show(1) }),
+		widget.NewButtonWithIcon("", theme.CancelIcon(), hide)), f.entry)
+	f.box.Hide()
+	return f
+}
+
+// Find opens the find bar of the current table.
+func (t *DataBrowser) Find() {
+	table, _ := t.currentTable()
+	f := t.findBars[table]
+	if f == nil {
+		return
+	}
+	f.box.Show()
+	t.w.Canvas().Focus(f.entry)
+}
@@ -0,0 +1,53 @@
+package windows
+
+import (
+	"errors"
+	"slices"
+
+	"fyne.io/fyne/v2"
+)
+
+var errNoFindTerm = errors.New("type a term in the find bar first, rows are exported by whether they contain it")
+
+// findRows returns the rows of dataItem with a cell containing the find
+// term, or with matching unset those without one. Values are taken as
+// displayed unless fullPrecision is set.
+func findRows(dataItem *Data, matching, fullPrecision bool) Data {
+	rows := Data{header: dataItem.header, fields: dataItem.fields, source: dataItem.source, format: dataItem.format}
+	for r, values := range dataItem.data {
+		if slices.ContainsFunc(values, dataItem.isFindMatch) != matching {
+			continue
+		}
+		v := make([]string, len(values))
+		nulls := make([]bool, len(values))
+		for c := range values {
+			v[c] = dataItem.exportValue(r, c, fullPrecision)
+			nulls[c] = dataItem.isNull(r, c)
+		}
+		rows.data = append(rows.data, v)
+		rows.nulls = append(rows.nulls, nulls)
+	}
+	return rows
+}
+
+// ExportFindRows exports the loaded rows of the current table that contain
+// the term of its find bar, or with matching unset the rows that do not,
+// which isolates the rows a data quality check rejects.
+func (t *DataBrowser) ExportFindRows(matching bool) {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
+	}
+	if dataItem.findTerm == "" {
+		t.showError(errNoFindTerm)
+		return
+	}
+	t.mu.Lock()
+	rows := findRows(dataItem, matching, exportFullPrecision())
+	t.mu.Unlock()
+	opts := exportOptions{
+		nullToken: fyne.CurrentApp().Preferences().String(exportNullTokenPreference),
+		format:    currentNumberFormat(),
+	}
+	t.saveExport(rows, opts, exportFormats[0])
+}
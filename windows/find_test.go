@@ -0,0 +1,38 @@
+package windows
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestNextMatch(t *testing.T) {
+	matches := []widget.TableCellID{{Row: 1, Col: 0}, {Row: 3, Col: 2}, {Row: 7, Col: 1}}
+	cell := func(row, col int) *widget.TableCellID {
+		return &widget.TableCellID{Row: row, Col: col}
+	}
+	tests := []struct {
+		name string
+		last *widget.TableCellID
+		step int
+		want int
+	}{
+		{"first next", nil, 1, 0},
+		{"first previous", nil, -1, 2},
+		{"next", cell(1, 0), 1, 1},
+		{"previous", cell(3, 2), -1, 0},
+		{"next wraps", cell(7, 1), 1, 0},
+		{"previous wraps", cell(1, 0), -1, 2},
+		{"next after a gone match", cell(3, 0), 1, 1},
+		{"previous before a gone match", cell(3, 3), -1, 1},
+		{"next after the last match", cell(9, 0), 1, 0},
+		{"previous before the first match", cell(0, 0), -1, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextMatch(matches, tt.last, tt.step); got != tt.want {
+				t.Errorf("nextMatch(%v, %d) = %d, want %d", tt.last, tt.step, got, tt.want)
+			}
+		})
+	}
+}
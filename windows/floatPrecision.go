@@ -0,0 +1,171 @@
+package windows
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+const (
+	floatPrecisionPreference  = "floatPrecision"
+	exportPrecisionPreference = "exportPrecision"
+	defaultFloatPrecision     = 2
+	maxFloatPrecision         = 15
+)
+
+// Precisions exports can be written in.
+const (
+	exportPrecisionDisplayed = "As displayed"
+	exportPrecisionFull      = "Full"
+)
+
+var exportPrecisions = []string{exportPrecisionDisplayed, exportPrecisionFull}
+
+// exportFullPrecision reports whether exports are set to write floats,
+// decimals and timestamps at full precision instead of as displayed.
+func exportFullPrecision() bool {
+	return fyne.CurrentApp().Preferences().StringWithFallback(exportPrecisionPreference, exportPrecisionDisplayed) == exportPrecisionFull
+}
+
+// currentFloatPrecision is the number of decimals shown for float columns
+// that have no precision of their own.
+func currentFloatPrecision() int {
+	return fyne.CurrentApp().Preferences().IntWithFallback(floatPrecisionPreference, defaultFloatPrecision)
+}
+
+// SetFloatPrecision changes the default number of decimals of float columns
+// in all open tables.
+func (t *DataBrowser) SetFloatPrecision(prec int) {
+	fyne.CurrentApp().Preferences().SetInt(floatPrecisionPreference, prec)
+	for table := range t.tables {
+		table.Refresh()
+	}
+}
+
+// float32Value widens v without the spurious digits a plain conversion adds,
+// so 0.1 stays 0.1 instead of 0.10000000149011612.
+func float32Value(v float32) float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'g', -1, 32), 64)
+	return f
+}
+
+// isFloat reports whether col holds 32 or 64 bit floats. They are stored at
+// full precision and rounded when shown or exported.
+func (d *Data) isFloat(col int) bool {
+	if col >= len(d.fields) {
+		return false
+	}
+	id := d.fields[col].Type.ID()
+	return id == arrow.FLOAT32 || id == arrow.FLOAT64
+}
+
+// isDecimal reports whether col holds Arrow decimals. They are stored at
+// the scale of their column and only rounded when the column is given a
+// precision of its own.
+func (d *Data) isDecimal(col int) bool {
+	return col < len(d.fields) && d.fields[col].Type.ID() == arrow.DECIMAL128
+}
+
+// precision is the number of decimals shown for float column col.
+func (d *Data) precision(col int) int {
+	if p, ok := d.precisions[col]; ok {
+		return p
+	}
+	return currentFloatPrecision()
+}
+
+// displayValue is cellValue as shown in the grid: floats rounded to the
+// precision of their column, decimals rounded if their column has one and
+// timestamps cut to the chosen resolution. Exports write it too unless they
+// are set to full precision.
+func (d *Data) displayValue(row, col int) string {
+	v := d.cellValue(row, col)
+	if d.isNull(row, col) {
+		return v
+	}
+	switch {
+	case d.isFloat(col):
+		f, err := d.format.parseFloat(v)
+		if err != nil {
+			return v
+		}
+		return d.format.float(f, d.precision(col))
+	case d.isDecimal(col):
+		p, ok := d.precisions[col]
+		if !ok {
+			return v
+		}
+		r, ok := new(big.Rat).SetString(d.format.delocalize(v))
+		if !ok {
+			return v
+		}
+		return d.format.localize(r.FloatString(p))
+	case d.isTimestamp(col):
+		return displayTimestamp(v)
+	}
+	return v
+}
+
+// exportValue is the value of a cell written by an export: the displayed
+// value, or with full the stored value at full precision.
+func (d *Data) exportValue(row, col int, full bool) string {
+	if full {
+		return d.cellValue(row, col)
+	}
+	return d.displayValue(row, col)
+}
+
+// precisionMenuItem lets the user choose the decimals of a float or
+// decimal column.
+func (t *DataBrowser) precisionMenuItem(dataItem *Data, col int) *fyne.MenuItem {
+	if !dataItem.isFloat(col) && !dataItem.isDecimal(col) {
+		return nil
+	}
+	hint := fmt.Sprintf("Default is %d, set in the settings", currentFloatPrecision())
+	if dataItem.isDecimal(col) {
+		hint = "Default is the scale of the column"
+	}
+	return fyne.NewMenuItem("Decimals...", func() {
+		const useDefault = "Default"
+		options := []string{useDefault}
+		for p := 0; p <= maxFloatPrecision; p++ {
+			options = append(options, strconv.Itoa(p))
+		}
+		decimals := widget.NewSelect(options, nil)
+		decimals.Selected = useDefault
+		t.mu.Lock()
+		if p, ok := dataItem.precisions[col]; ok {
+			decimals.Selected = strconv.Itoa(p)
+		}
+		name := dataItem.header[col]
+		t.mu.Unlock()
+		dialog.NewForm("Decimals of "+name, "Apply", "Cancel", []*widget.FormItem{
+			{Text: "Decimals", Widget: decimals, HintText: hint},
+		}, func(ok bool) {
+			if !ok {
+				return
+			}
+			t.mu.Lock()
+			if decimals.Selected == useDefault {
+				delete(dataItem.precisions, col)
+			} else {
+				p, _ := strconv.Atoi(decimals.Selected)
+				if dataItem.precisions == nil {
+					dataItem.precisions = make(map[int]int)
+				}
+				dataItem.precisions[col] = p
+			}
+			t.mu.Unlock()
+			for table, d := range t.tables {
+				if d == dataItem {
+					table.Refresh()
+				}
+			}
+		}, t.w).Show()
+	})
+}
@@ -0,0 +1,61 @@
+package windows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	delta_sharing "github.com/magpierre/go_delta_sharing_client"
+)
+
+// GoToTable asks for the share.schema.table name of a table, completing it
+// from all tables of the profile, and opens it.
+func (t *MainWindow) GoToTable() {
+	if t.profile == "" {
+		t.showError(errors.New("no profile is loaded"))
+		return
+	}
+	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), t.profile, "")
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	tables, err := ds.ListAllTables()
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	names := make([]string, len(tables))
+	byName := make(map[string]delta_sharing.Table, len(tables))
+	for i, tbl := range tables {
+		names[i] = qualifiedName(tbl.Share, tbl.Schema, tbl.Name)
+		byName[strings.ToLower(names[i])] = tbl
+	}
+	name := widget.NewSelectEntry(names)
+	name.SetPlaceHolder("share.schema.table")
+	name.OnChanged = func(s string) {
+		var matches []string
+		for _, n := range names {
+			if fuzzyMatch(s, n) {
+				matches = append(matches, n)
+			}
+		}
+		name.SetOptions(matches)
+	}
+	dialog.NewForm("Go to Table", "Open", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("Table", name),
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		tbl, found := byName[strings.ToLower(strings.TrimSpace(name.Text))]
+		if !found {
+			t.showError(fmt.Errorf("there is no table named %q in this profile", name.Text))
+			return
+		}
+		t.selectTable(tbl.Share, tbl.Schema, tbl.Name)
+	}, t.w).Show()
+}
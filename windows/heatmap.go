@@ -0,0 +1,94 @@
+package windows
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// Colors of the lowest and highest values of a color scale. They are
+// translucent so the text stays readable in light and dark themes.
+var (
+	heatLow  = color.NRGBA{R: 0x2b, G: 0x83, B: 0xba, A: 0x70}
+	heatHigh = color.NRGBA{R: 0xd7, G: 0x19, B: 0x1c, A: 0x70}
+)
+
+// heatRange is the lowest and highest loaded value of a color scale column.
+type heatRange struct {
+	lo, hi float64
+}
+
+// heatColor returns the background of the cell at row, col when its column
+// has a color scale: a color between heatLow and heatHigh by where the
+// value lies between the column's minimum and maximum. Nulls and columns
+// without a color scale are not colored. The range is computed on first
+// use and again after more rows are loaded.
+func (d *Data) heatColor(row, col int) (color.Color, bool) {
+	r, ok := d.heatmaps[col]
+	if !ok || d.isNull(row, col) {
+		return nil, false
+	}
+	if r == nil {
+		values, ok := numericColumn(d, col)
+		if !ok || len(values) == 0 {
+			return nil, false
+		}
+		r = &heatRange{lo: values[0], hi: values[0]}
+		for _, v := range values {
+			r.lo, r.hi = min(r.lo, v), max(r.hi, v)
+		}
+		d.heatmaps[col] = r
+	}
+	v, err := d.format.parseFloat(d.data[row][col])
+	if err != nil || math.IsNaN(v) {
+		return nil, false
+	}
+	f := 0.5
+	if r.hi > r.lo {
+		// Clamped, as rows loaded since the range was found may lie
+		// outside it.
+		f = max(0, min(1, (v-r.lo)/(r.hi-r.lo)))
+	}
+	mix := func(a, b uint8) uint8 {
+		return uint8(float64(a) + f*(float64(b)-float64(a)))
+	}
+	return color.NRGBA{
+		R: mix(heatLow.R, heatHigh.R),
+		G: mix(heatLow.G, heatHigh.G),
+		B: mix(heatLow.B, heatHigh.B),
+		A: mix(heatLow.A, heatHigh.A),
+	}, true
+}
+
+// heatmapMenuItem switches the color scale of a numeric column on or off.
+func (t *DataBrowser) heatmapMenuItem(dataItem *Data, col int) *fyne.MenuItem {
+	if col >= len(dataItem.fields) {
+		return nil
+	}
+	switch typeCategory(dataItem.fields[col].Type) {
+	case "integer", "float", "decimal":
+	default:
+		return nil
+	}
+	_, on := dataItem.heatmaps[col]
+	item := fyne.NewMenuItem("Color Scale", func() {
+		t.mu.Lock()
+		if on {
+			delete(dataItem.heatmaps, col)
+		} else {
+			if dataItem.heatmaps == nil {
+				dataItem.heatmaps = make(map[int]*heatRange)
+			}
+			dataItem.heatmaps[col] = nil
+		}
+		t.mu.Unlock()
+		for table, d := range t.tables {
+			if d == dataItem {
+				table.Refresh()
+			}
+		}
+	})
+	item.Checked = on
+	return item
+}
@@ -0,0 +1,116 @@
+package windows
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// keyColumnsPreferencePrefix is followed by the source of a table to store
+// the names of its key columns.
+const keyColumnsPreferencePrefix = "keyColumns:"
+
+// keyColumns returns the saved key columns of dataItem, if any.
+func (d *Data) keyColumns() map[string]bool {
+	var names []string
+	json.Unmarshal([]byte(fyne.CurrentApp().Preferences().String(keyColumnsPreferencePrefix+d.source)), &names)
+	keys := make(map[string]bool, len(names))
+	for _, name := range names {
+		keys[name] = true
+	}
+	return keys
+}
+
+// updateColumnWidths collapses the columns of table that are not key
+// columns while the tab shows key columns only, and gives all others the
+// default width at the current zoom.
+func (t *DataBrowser) updateColumnWidths(table *widget.Table, dataItem *Data) {
+	width := widget.NewLabel("template.............").MinSize().Width * t.zoomOf(table)
+	keys := dataItem.keyColumns()
+	t.mu.Lock()
+	widths := make([]float32, len(dataItem.header))
+	for col, name := range dataItem.header {
+		if !dataItem.keyOnly || len(keys) == 0 || keys[name] {
+			widths[col] = width
+		}
+	}
+	t.mu.Unlock()
+	for col, w := range widths {
+		table.SetColumnWidth(col, w)
+	}
+	table.Refresh()
+}
+
+// newKeyColumnsCheck switches a tab between all columns and its key columns.
+func (t *DataBrowser) newKeyColumnsCheck(table *widget.Table, dataItem *Data) *widget.Check {
+	check := widget.NewCheck("Key columns only", func(b bool) {
+		dataItem.keyOnly = b
+		t.updateColumnWidths(table, dataItem)
+	})
+	check.Checked = dataItem.keyOnly
+	t.keyChecks[table] = check
+	return check
+}
+
+// ToggleKeyColumns switches the current tab between all columns and its key
+// columns.
+func (t *DataBrowser) ToggleKeyColumns() {
+	table, _ := t.currentTable()
+	if check, ok := t.keyChecks[table]; ok {
+		check.SetChecked(!check.Checked)
+	}
+}
+
+// EditKeyColumns lets the user save or clear the key columns of the current
+// table. They are remembered per table source, so reopening the table can
+// show just those columns with one click.
+func (t *DataBrowser) EditKeyColumns() {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
+	}
+	key := keyColumnsPreferencePrefix + dataItem.source
+	t.mu.Lock()
+	header := slices.Clone(dataItem.header)
+	t.mu.Unlock()
+	var selected []string
+	keys := dataItem.keyColumns()
+	for _, name := range header {
+		if keys[name] {
+			selected = append(selected, name)
+		}
+	}
+	columns := widget.NewCheckGroup(header, nil)
+	columns.SetSelected(selected)
+
+	save := func(names []string) {
+		if len(names) == 0 {
+			fyne.CurrentApp().Preferences().RemoveValue(key)
+		} else {
+			b, _ := json.Marshal(names)
+			fyne.CurrentApp().Preferences().SetString(key, string(b))
+		}
+		t.updateColumnWidths(table, dataItem)
+		t.setStatus(fmt.Sprintf("%d key columns saved for %s", len(names), dataItem.source))
+	}
+	var d *dialog.CustomDialog
+	d = dialog.NewCustomWithoutButtons("Key Columns", container.NewVScroll(columns), t.w)
+	d.SetButtons([]fyne.CanvasObject{
+		widget.NewButton("Clear", func() {
+			d.Hide()
+			save(nil)
+		}),
+		widget.NewButton("Cancel", d.Hide),
+		widget.NewButton("Save", func() {
+			d.Hide()
+			save(columns.Selected)
+		}),
+	})
+	d.Resize(fyne.NewSize(300, 400))
+	d.Show()
+}
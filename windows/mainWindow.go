@@ -2,7 +2,10 @@ package windows
 
 import (
 	"context"
+	"errors"
 	"io"
+	"slices"
+	"sync"
 	"time"
 
 	"dsb/windows/resources"
@@ -13,6 +16,8 @@ import (
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/storage"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 	th "fyne.io/x/fyne/theme"
@@ -40,6 +45,14 @@ type MainWindow struct {
 	shareBindingList         binding.StringList
 	schemaBindingList        binding.StringList
 	tablesBindingList        binding.StringList
+	profileURI               fyne.URI
+	authBanner               *fyne.Container
+	status                   *widget.Label
+	shareWidget              *widget.List
+	schemaWidget             *widget.List
+	tablesWidget             *widget.List
+	commands                 []Command
+	tableSizes               sync.Map
 }
 
 func CreateMainWindow() *MainWindow {
@@ -53,37 +66,206 @@ func (t *MainWindow) OpenProfile() *dialog.FileDialog {
 		if err != nil || uc == nil {
 			return
 		}
+		defer uc.Close()
 
 		d, err := io.ReadAll(uc)
 		if err != nil {
-			dialog.NewError(err, t.w)
+			t.showError(err)
 			return
 		}
-		t.profile = string(d)
+		t.profileURI = uc.URI()
+		t.a.Preferences().SetString(lastProfilePreference, t.profileURI.String())
+		t.loadProfile(string(d))
+	}, t.w)
+	return d
+}
+
+// ReloadProfile reads the profile file again, picking up a refreshed bearer
+// token without restarting. Without a known file the open dialog is shown.
+func (t *MainWindow) ReloadProfile() {
+	if t.profileURI == nil {
+		t.OpenProfile().Show()
+		return
+	}
+	r, err := storage.Reader(t.profileURI)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	defer r.Close()
+	d, err := io.ReadAll(r)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.loadProfile(string(d))
+}
 
+// RefreshShares lists the shares of the current profile again, keeping the
+// selected share and schema when they still exist.
+func (t *MainWindow) RefreshShares() {
+	if t.profile == "" {
+		return
+	}
+	share, schema := t.selected.share, t.selected.schema
+	t.status.SetText("Refreshing shares...")
+	go func() {
+		defer t.status.SetText("")
 		ds, err := delta_sharing.NewSharingClientFromString(context.Background(), t.profile, "")
 		if err != nil {
-			dialog.NewError(err, t.w).Show()
+			t.showError(err)
+			return
 		}
-
-		ds.ListShares()
-
-		share, _ := ds.ListShares()
-		t.share = make([]string, 0)
-		t.schemas = make([]string, 0)
-		t.tables = make([]string, 0)
-		t.files = make([]string, 0)
-		t.selected = Selected{}
-		t.w.Content().Refresh()
-		for _, s := range share {
+		shares, err := ds.ListShares()
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		t.authBanner.Hide()
+		t.share = make([]string, 0, len(shares))
+		for _, s := range shares {
 			t.share = append(t.share, s.Name)
 		}
-
 		t.shareBindingList.Set(t.share)
+		t.shareWidget.UnselectAll()
+		t.schemas = make([]string, 0)
+		t.tables = make([]string, 0)
+		t.selected = Selected{}
 		t.schemaBindingList.Set(t.schemas)
 		t.tablesBindingList.Set(t.tables)
-	}, t.w)
-	return d
+		if i := slices.Index(t.share, share); i >= 0 {
+			t.shareWidget.Select(i)
+			if j := slices.Index(t.schemas, schema); j >= 0 {
+				t.schemaWidget.Select(j)
+			}
+		}
+	}()
+}
+
+// openLastProfile loads the most recently opened profile file, reporting
+// false if there is none or it can no longer be read.
+func (t *MainWindow) openLastProfile() bool {
+	last := t.a.Preferences().String(lastProfilePreference)
+	if last == "" {
+		return false
+	}
+	uri, err := storage.ParseURI(last)
+	if err != nil {
+		return false
+	}
+	r, err := storage.Reader(uri)
+	if err != nil {
+		return false
+	}
+	defer r.Close()
+	d, err := io.ReadAll(r)
+	if err != nil {
+		return false
+	}
+	t.profileURI = uri
+	t.loadProfile(string(d))
+	return true
+}
+
+func (t *MainWindow) loadProfile(profile string) {
+	profile = yamlProfileToJSON(profile, t.profileURI)
+	if err := checkProfile(profile); err != nil {
+		t.showProfileError(profile, err)
+		return
+	}
+	profile, err := expandProfileEnv(profile)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.profile = profile
+	t.tableSizes.Range(func(key, _ any) bool {
+		t.tableSizes.Delete(key)
+		return true
+	})
+
+	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), t.profile, "")
+	if err != nil {
+		t.showError(err)
+		return
+	}
+
+	share, err := ds.ListShares()
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.authBanner.Hide()
+	t.share = make([]string, 0)
+	t.schemas = make([]string, 0)
+	t.tables = make([]string, 0)
+	t.files = make([]string, 0)
+	t.selected = Selected{}
+	t.w.Content().Refresh()
+	for _, s := range share {
+		t.share = append(t.share, s.Name)
+	}
+
+	t.shareBindingList.Set(t.share)
+	t.schemaBindingList.Set(t.schemas)
+	t.tablesBindingList.Set(t.tables)
+}
+
+// showProfileError explains why profile could not be used. A JSON file that
+// is not a profile can be opened as data instead.
+func (t *MainWindow) showProfileError(profile string, err error) {
+	if !errors.Is(err, errNotProfileJSON) {
+		dialog.NewError(err, t.w).Show()
+		return
+	}
+	dialog.NewConfirm("Not a Delta Sharing profile",
+		err.Error()+"\nOpen the file as JSON data instead?",
+		func(ok bool) {
+			if !ok {
+				return
+			}
+			if err := t.browser().OpenJSONText(profile, "profile"); err != nil {
+				t.showError(err)
+			}
+		}, t.w).Show()
+}
+
+// showError reports err to the user. Errors caused by an expired or invalid
+// token show the reconnect banner and offer to reload the profile. As the
+// sharing client does not say why a call failed, its errors are reported
+// after checking the token with the server.
+func (t *MainWindow) showError(err error) {
+	var clientErr *delta_sharing.DSErr
+	if !errors.As(err, &clientErr) || t.profile == "" {
+		t.reportError(err)
+		return
+	}
+	profile := t.profile
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tokenCheckTimeout)
+		defer cancel()
+		if authErr := checkToken(ctx, profile); authErr != nil {
+			err = authErr
+		}
+		t.reportError(err)
+	}()
+}
+
+// reportError shows err, or the reconnect banner and dialog for an
+// *authError.
+func (t *MainWindow) reportError(err error) {
+	if !isAuthError(err) {
+		dialog.NewError(err, t.w).Show()
+		return
+	}
+	t.authBanner.Show()
+	dialog.NewConfirm("Token expired or invalid",
+		"The sharing server rejected the bearer token.\nReload the profile?",
+		func(ok bool) {
+			if ok {
+				t.ReloadProfile()
+			}
+		}, t.w).Show()
 }
 
 func (t *MainWindow) NewMainWindow() {
@@ -93,7 +275,14 @@ func (t *MainWindow) NewMainWindow() {
 	t.top = widget.NewToolbar()
 	t.left = container.NewVBox()
 	t.right = container.NewVBox()
-	t.bottom = container.NewHBox()
+	t.authBanner = container.NewHBox(
+		widget.NewIcon(theme.WarningIcon()),
+		widget.NewLabel("Token expired or invalid"),
+		widget.NewButton("Reload profile", t.ReloadProfile),
+		widget.NewButton("Open profile...", func() { t.OpenProfile().Show() }))
+	t.authBanner.Hide()
+	t.status = widget.NewLabel("")
+	t.bottom = container.NewHBox(t.authBanner, t.status)
 	t.shareBindingList = binding.NewStringList()
 	t.schemaBindingList = binding.NewStringList()
 	t.tablesBindingList = binding.NewStringList()
@@ -104,23 +293,44 @@ func (t *MainWindow) NewMainWindow() {
 	logo.FillMode = canvas.ImageFillContain
 
 	shareWidget := widget.NewListWithData(t.shareBindingList, func() fyne.CanvasObject {
-		return widget.NewLabel("template")
+		return newMenuLabel()
 	}, func(di binding.DataItem, co fyne.CanvasObject) {
-		co.(*widget.Label).Bind(di.(binding.String))
+		l := co.(*menuLabel)
+		l.Bind(di.(binding.String))
+		l.menu = func() *fyne.Menu {
+			name, _ := di.(binding.String).Get()
+			return t.pathMenu(name)
+		}
 	})
 
 	schemaWidget := widget.NewListWithData(t.schemaBindingList, func() fyne.CanvasObject {
-		return widget.NewLabel("template")
+		return newMenuLabel()
 	}, func(di binding.DataItem, co fyne.CanvasObject) {
-		co.(*widget.Label).Bind(di.(binding.String))
+		l := co.(*menuLabel)
+		l.Bind(di.(binding.String))
+		l.menu = func() *fyne.Menu {
+			name, _ := di.(binding.String).Get()
+			return t.pathMenu(t.selected.share, name)
+		}
 	})
 
 	tablesWidget := widget.NewListWithData(t.tablesBindingList, func() fyne.CanvasObject {
-		return widget.NewLabel("template")
+		return newMenuLabel()
 	}, func(di binding.DataItem, co fyne.CanvasObject) {
-		co.(*widget.Label).Bind(di.(binding.String))
+		l := co.(*menuLabel)
+		l.Bind(tableLabelBinding{String: di.(binding.String), label: t.tableLabel})
+		l.menu = func() *fyne.Menu {
+			name, _ := di.(binding.String).Get()
+			return t.pathMenu(t.selected.share, t.selected.schema, name)
+		}
+		l.hover = func(entered bool) {
+			name, _ := di.(binding.String).Get()
+			t.showTableSize(t.selected.share, t.selected.schema, name, entered)
+		}
 	})
 
+	t.shareWidget, t.schemaWidget, t.tablesWidget = shareWidget, schemaWidget, tablesWidget
+
 	gr := container.NewVSplit(widget.NewCard("", "Shares", shareWidget), widget.NewCard("", "Schemas", schemaWidget))
 	t.left = container.NewGridWrap(fyne.NewSize(150, 768), gr)
 	tabs := container.NewDocTabs(container.NewTabItem("Tables", widget.NewCard("", "Tables", tablesWidget)))
@@ -131,6 +341,12 @@ func (t *MainWindow) NewMainWindow() {
 	}
 
 	t.docTabs = tabs
+	tabs.OnSelected = func(ti *container.TabItem) {
+		// Leaving the browser stops the tables loading in the background.
+		if ti.Text != "Browser" && t.dataBrowser != nil {
+			t.dataBrowser.stopLoadsExcept(nil)
+		}
+	}
 	shareWidget.OnSelected = func(id widget.ListItemID) {
 		x := t.share[id]
 		t.selected.share = x
@@ -161,12 +377,8 @@ func (t *MainWindow) NewMainWindow() {
 		t.schemaBindingList.Set(t.schemas)
 		t.tablesBindingList.Set(t.tables)
 		fileSelected := t.files[0]
-		if t.dataBrowser == nil {
-			var db DataBrowser
-			db.CreateWindow(t.docTabs)
-			t.dataBrowser = &db
-		}
-		t.dataBrowser.GetData(t.profile, t.selected.table, fileSelected)
+		t.browser().GetData(t.profile, t.profileName(), t.selected.table, fileSelected)
+		t.rememberTable()
 		/*da := NewDataAggregator()
 		ti := da.CreateTab(t.dataBrowser.parseRecord().header)
 		t.docTabs.Append(ti)
@@ -175,19 +387,31 @@ func (t *MainWindow) NewMainWindow() {
 		t.docTabs.SelectIndex(1)
 	}
 
-	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(theme.MenuIcon(), func() {
-		if !t.left.Visible() {
-			t.left.Show()
-		} else {
-			t.left.Hide()
-		}
-	}))
+	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(theme.MenuIcon(), t.toggleNavigation))
 	t.top.(*widget.Toolbar).Append(widget.NewToolbarSeparator())
 	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(
 		theme.FileIcon(), func() {
 			d := t.OpenProfile()
 			d.Show()
 		}))
+	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(theme.ViewRefreshIcon(), t.RefreshShares))
+	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(theme.HistoryIcon(), t.ShowRecentTables))
+	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(
+		theme.ContentPasteIcon(), func() {
+			if err := t.browser().PasteAsTable(); err != nil {
+				t.showError(err)
+			}
+		}))
+	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(
+		theme.FolderOpenIcon(), func() {
+			t.browser().OpenParquetFolder()
+		}))
+	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(
+		theme.StorageIcon(), func() {
+			t.browser().OpenCloudFile()
+		}))
+
+	t.top.(*widget.Toolbar).Append(widget.NewToolbarAction(theme.SettingsIcon(), t.ShowSettings))
 
 	t.top.(*widget.Toolbar).Append(widget.NewToolbarSpacer())
 
@@ -198,10 +422,78 @@ func (t *MainWindow) NewMainWindow() {
 
 	c := container.NewBorder(t.top, t.bottom, t.left, t.right, widget.NewCard("", "", tabs))
 	t.w.SetContent(c)
-	t.OpenProfile().Show()
+	t.registerCommands()
+	t.w.Canvas().AddShortcut(&desktop.CustomShortcut{
+		KeyName:  fyne.KeyK,
+		Modifier: fyne.KeyModifierShortcutDefault,
+	}, func(fyne.Shortcut) {
+		t.ShowCommandPalette()
+	})
+	t.w.Canvas().AddShortcut(&desktop.CustomShortcut{
+		KeyName:  fyne.KeyL,
+		Modifier: fyne.KeyModifierShortcutDefault,
+	}, func(fyne.Shortcut) {
+		t.browser().GoToColumn()
+	})
+	for _, s := range []struct {
+		key      fyne.KeyName
+		modifier fyne.KeyModifier
+		action   func(*DataBrowser)
+	}{
+		{fyne.KeyTab, fyne.KeyModifierControl, func(db *DataBrowser) { db.SelectNextTab(1) }},
+		{fyne.KeyTab, fyne.KeyModifierControl | fyne.KeyModifierShift, func(db *DataBrowser) { db.SelectNextTab(-1) }},
+		{fyne.KeyW, fyne.KeyModifierShortcutDefault, (*DataBrowser).CloseCurrentTab},
+	} {
+		action := s.action
+		t.w.Canvas().AddShortcut(&desktop.CustomShortcut{KeyName: s.key, Modifier: s.modifier}, func(fyne.Shortcut) {
+			if t.dataBrowser != nil && t.docTabs.Selected() != nil && t.docTabs.Selected().Text == "Browser" {
+				action(t.dataBrowser)
+			}
+		})
+	}
+	// Focused entries copy their own text, so this only runs while the
+	// grid or nothing has the focus.
+	t.w.Canvas().AddShortcut(&fyne.ShortcutCopy{}, func(fyne.Shortcut) {
+		if t.dataBrowser != nil && t.docTabs.Selected() != nil && t.docTabs.Selected().Text == "Browser" {
+			t.dataBrowser.CopySelection()
+		}
+	})
+	t.w.Canvas().AddShortcut(&desktop.CustomShortcut{
+		KeyName:  fyne.KeyF,
+		Modifier: fyne.KeyModifierShortcutDefault,
+	}, func(fyne.Shortcut) {
+		t.browser().Find()
+	})
+	t.w.SetOnClosed(func() {
+		if t.dataBrowser != nil {
+			t.dataBrowser.Close()
+		}
+	})
+	if !t.a.Preferences().Bool(reopenLastProfilePreference) || !t.openLastProfile() {
+		t.OpenProfile().Show()
+	}
 	t.w.ShowAndRun()
 }
 
+func (t *MainWindow) toggleNavigation() {
+	if !t.left.Visible() {
+		t.left.Show()
+	} else {
+		t.left.Hide()
+	}
+}
+
+func (t *MainWindow) browser() *DataBrowser {
+	if t.dataBrowser == nil {
+		var db DataBrowser
+		db.CreateWindow(t.docTabs)
+		db.showError = t.showError
+		db.setStatus = t.status.SetText
+		t.dataBrowser = &db
+	}
+	return t.dataBrowser
+}
+
 func (t *MainWindow) ScanTree() {
 	c := make(chan bool)
 	go func(c chan bool) {
@@ -223,17 +515,17 @@ func (t *MainWindow) ScanTree() {
 	}(c)
 	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), t.profile, "")
 	if err != nil {
-		dialog.NewError(err, t.w).Show()
+		t.showError(err)
 	}
 	ls, err := ds.ListShares()
 	if err != nil {
-		dialog.NewError(err, t.w).Show()
+		t.showError(err)
 	}
 	for _, v := range ls {
 		if v.Name == t.selected.share {
 			sh, err := ds.ListSchemas(v)
 			if err != nil {
-				dialog.NewError(err, t.w).Show()
+				t.showError(err)
 			}
 			t.schemas = make([]string, 0)
 			t.tables = make([]string, 0)
@@ -243,7 +535,7 @@ func (t *MainWindow) ScanTree() {
 				if v2.Name == t.selected.schema && v2.Share == t.selected.share {
 					tl, err := ds.ListTables(v2)
 					if err != nil {
-						dialog.NewError(err, t.w).Show()
+						t.showError(err)
 					}
 					for _, tle := range tl {
 						t.tables = append(t.tables, tle.Name)
@@ -251,7 +543,7 @@ func (t *MainWindow) ScanTree() {
 							t.selected.table = tle
 							re, err := ds.ListFilesInTable(tle)
 							if err != nil {
-								dialog.NewError(err, t.w).Show()
+								t.showError(err)
 							}
 							t.files = make([]string, 0)
 							for _, v := range re.AddFiles {
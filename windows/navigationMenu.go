@@ -0,0 +1,54 @@
+package windows
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+const pathSeparatorPreference = "pathSeparator"
+
+// menuLabel is a navigation list entry that shows a context menu when it
+// is right clicked and calls hover when the mouse enters or moves over it.
+type menuLabel struct {
+	widget.Label
+	menu  func() *fyne.Menu
+	hover func(entered bool)
+}
+
+func newMenuLabel() *menuLabel {
+	l := &menuLabel{}
+	l.ExtendBaseWidget(l)
+	l.SetText("template")
+	return l
+}
+
+func (l *menuLabel) TappedSecondary(e *fyne.PointEvent) {
+	if l.menu == nil {
+		return
+	}
+	c := fyne.CurrentApp().Driver().CanvasForObject(l)
+	widget.ShowPopUpMenuAtPosition(l.menu(), c, e.AbsolutePosition)
+}
+
+// pathMenu builds the context menu of a navigation entry. parts is the path
+// of the entry, from the share down to the entry itself.
+func (t *MainWindow) pathMenu(parts ...string) *fyne.Menu {
+	name := parts[len(parts)-1]
+	items := []*fyne.MenuItem{
+		fyne.NewMenuItem("Copy Name", func() {
+			t.w.Clipboard().SetContent(name)
+		}),
+	}
+	if len(parts) > 1 {
+		sep := t.a.Preferences().StringWithFallback(pathSeparatorPreference, ".")
+		items = append(items, fyne.NewMenuItem("Copy Full Path", func() {
+			t.w.Clipboard().SetContent(strings.Join(parts, sep))
+		}))
+	}
+	if len(parts) == 3 {
+		items = append(items, fyne.NewMenuItemSeparator(), t.schemaOnlyMenuItem(parts), t.tableNotesMenuItem(parts))
+	}
+	return fyne.NewMenu("", items...)
+}
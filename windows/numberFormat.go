@@ -0,0 +1,100 @@
+package windows
+
+import (
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+const numberFormatPreference = "numberFormat"
+
+// numberFormat is the locale used to show decimal numbers and to write CSV.
+// The zero value is the US style format.
+type numberFormat struct {
+	name    string
+	decimal string
+	group   string
+	comma   rune
+}
+
+var numberFormats = []numberFormat{
+	{name: "US (1234.56, comma separated)", decimal: ".", comma: ','},
+	{name: "European (1.234,56, semicolon separated)", decimal: ",", group: ".", comma: ';'},
+}
+
+func numberFormatNames() []string {
+	names := make([]string, len(numberFormats))
+	for i, f := range numberFormats {
+		names[i] = f.name
+	}
+	return names
+}
+
+// currentNumberFormat returns the format chosen in the settings, writing
+// CSV with the delimiter chosen there.
+func currentNumberFormat() numberFormat {
+	name := fyne.CurrentApp().Preferences().String(numberFormatPreference)
+	for _, f := range numberFormats {
+		if f.name == name {
+			return f.withCSVDelimiter(currentCSVDelimiter())
+		}
+	}
+	return numberFormats[0].withCSVDelimiter(currentCSVDelimiter())
+}
+
+// float formats v with prec decimals.
+func (f numberFormat) float(v float64, prec int) string {
+	return f.localize(strconv.FormatFloat(v, 'f', prec, 64))
+}
+
+// localize rewrites a plain decimal number such as -1234.5 with the
+// separators of f.
+func (f numberFormat) localize(s string) string {
+	if f.decimal == "" || (f.decimal == "." && f.group == "") {
+		return s
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	sign := ""
+	if strings.HasPrefix(whole, "-") {
+		sign, whole = "-", whole[1:]
+	}
+	if f.group != "" {
+		var b strings.Builder
+		for i, r := range whole {
+			if i > 0 && (len(whole)-i)%3 == 0 {
+				b.WriteString(f.group)
+			}
+			b.WriteRune(r)
+		}
+		whole = b.String()
+	}
+	if frac == "" {
+		return sign + whole
+	}
+	return sign + whole + f.decimal + frac
+}
+
+// parseFloat reads a number written by float.
+func (f numberFormat) parseFloat(s string) (float64, error) {
+	return strconv.ParseFloat(f.delocalize(s), 64)
+}
+
+// delocalize undoes localize.
+func (f numberFormat) delocalize(s string) string {
+	if f.group != "" {
+		s = strings.ReplaceAll(s, f.group, "")
+	}
+	if f.decimal != "" && f.decimal != "." {
+		s = strings.Replace(s, f.decimal, ".", 1)
+	}
+	return s
+}
+
+// csvComma is the field delimiter of exported CSV files.
+func (f numberFormat) csvComma() rune {
+	if f.comma == 0 {
+		return ','
+	}
+	return f.comma
+}
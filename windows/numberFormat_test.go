@@ -0,0 +1,31 @@
+package windows
+
+import "testing"
+
+func TestNumberFormatLocalize(t *testing.T) {
+	us, european := numberFormats[0], numberFormats[1]
+	tests := []struct {
+		name   string
+		format numberFormat
+		plain  string
+		want   string
+	}{
+		{"zero value", numberFormat{}, "-1234.5", "-1234.5"},
+		{"US", us, "1234567.25", "1234567.25"},
+		{"European", european, "1234567.25", "1.234.567,25"},
+		{"European negative", european, "-1234.5", "-1.234,5"},
+		{"European integer", european, "123", "123"},
+		{"European group boundary", european, "123456", "123.456"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.format.localize(tt.plain)
+			if got != tt.want {
+				t.Errorf("localize(%q) = %q, want %q", tt.plain, got, tt.want)
+			}
+			if back := tt.format.delocalize(got); back != tt.plain {
+				t.Errorf("delocalize(%q) = %q, want %q", got, back, tt.plain)
+			}
+		})
+	}
+}
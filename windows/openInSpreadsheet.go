@@ -0,0 +1,54 @@
+package windows
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"fyne.io/fyne/v2"
+)
+
+// OpenInSpreadsheet writes the loaded rows of the current table to a
+// temporary CSV file and opens it with the default application for CSV
+// files. The file is removed when the app exits.
+func (t *DataBrowser) OpenInSpreadsheet() {
+	_, dataItem := t.currentTable()
+	if dataItem == nil {
+		return
+	}
+	t.mu.Lock()
+	data, err := combineData([]*Data{dataItem}, []string{dataItem.source}, false, exportFullPrecision())
+	t.mu.Unlock()
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	f, err := os.CreateTemp("", "dsb-*.csv")
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	t.tempFiles = append(t.tempFiles, f.Name())
+	err = ExportToCSV(f, data, exportOptions{format: currentNumberFormat()})
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	u := &url.URL{Scheme: "file", Path: filepath.ToSlash(f.Name())}
+	if err := fyne.CurrentApp().OpenURL(u); err != nil {
+		t.showError(err)
+		return
+	}
+	t.setStatus("Opened " + dataItem.source + " in the spreadsheet app")
+}
+
+// removeTempFiles deletes the files written by OpenInSpreadsheet.
+func (t *DataBrowser) removeTempFiles() {
+	for _, name := range t.tempFiles {
+		os.Remove(name)
+	}
+	t.tempFiles = nil
+}
@@ -0,0 +1,192 @@
+package windows
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+// partitionValue is a col=value pair taken from a Hive style directory name.
+type partitionValue struct {
+	column, value string
+}
+
+// OpenParquetFolder asks for a directory and shows all Parquet files below
+// it as one table. A Delta table directory shows its latest version only.
+func (t *DataBrowser) OpenParquetFolder() {
+	dialog.NewFolderOpen(func(lu fyne.ListableURI, err error) {
+		if err != nil || lu == nil {
+			return
+		}
+		load := t.loadParquetFolder
+		if isDeltaTable(lu.Path()) {
+			load = t.loadDeltaFolder
+		}
+		if err := load(lu.Path()); err != nil {
+			t.showError(err)
+		}
+	}, t.w).Show()
+}
+
+// loadParquetFolder shows the Parquet files below dir as one table, with a
+// column for each partition key found in the directory names. Skipped files
+// are reported.
+func (t *DataBrowser) loadParquetFolder(dir string) error {
+	data, problems, err := t.readParquetFolder(dir)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		t.showError(fmt.Errorf("skipped %d file(s):\n%s", len(problems), strings.Join(problems, "\n")))
+	}
+	data.watch = &fileWatch{path: dir, reload: func() (Data, error) {
+		data, _, err := t.readParquetFolder(dir)
+		return data, err
+	}}
+	t.CreateDataBrowser(&data, filepath.Base(dir))
+	return nil
+}
+
+// readParquetFolder reads the schema of every .parquet file below dir and
+// the rows of the first one. Files that cannot be read or whose schema
+// differs from the first file are skipped and described in problems.
+func (t *DataBrowser) readParquetFolder(dir string) (Data, []string, error) {
+	paths, err := parquetFiles(dir)
+	if err != nil {
+		return Data{}, nil, err
+	}
+
+	var schema *arrow.Schema
+	var files, problems []string
+	for _, path := range paths {
+		s, err := parquetSchema(path)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+		if schema == nil {
+			schema = s
+		} else if !schema.Equal(s) {
+			problems = append(problems, fmt.Sprintf("%s: schema differs from %s", path, files[0]))
+			continue
+		}
+		files = append(files, path)
+	}
+	if len(files) == 0 {
+		return Data{}, problems, fmt.Errorf("no readable Parquet files in %s\n%s", dir, strings.Join(problems, "\n"))
+	}
+
+	load := func(path string) (arrow.Table, error) {
+		table, err := readParquetFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return withPartitionColumns(table, partitionValues(dir, path)), nil
+	}
+	first, err := load(files[0])
+	if err != nil {
+		return Data{}, problems, err
+	}
+	return t.newArrowData(first, dir, files[1:], load), problems, nil
+}
+
+// parquetFiles returns the .parquet files below dir in sorted order.
+func parquetFiles(dir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(d.Name(), ".parquet") {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	sort.Strings(paths)
+	return paths, err
+}
+
+func parquetSchema(path string) (*arrow.Schema, error) {
+	rdr, err := file.OpenParquetFile(path, false)
+	if err != nil {
+		return nil, err
+	}
+	defer rdr.Close()
+	fr, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	return fr.Schema()
+}
+
+func readParquetFile(path string) (arrow.Table, error) {
+	rdr, err := file.OpenParquetFile(path, false)
+	if err != nil {
+		return nil, err
+	}
+	defer rdr.Close()
+	fr, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	return fr.ReadTable(context.Background())
+}
+
+// partitionValues extracts the col=value directory names between dir and
+// the file at path.
+func partitionValues(dir, path string) []partitionValue {
+	rel, err := filepath.Rel(dir, filepath.Dir(path))
+	if err != nil || rel == "." {
+		return nil
+	}
+	var values []partitionValue
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		if column, value, ok := strings.Cut(part, "="); ok {
+			values = append(values, partitionValue{column, value})
+		}
+	}
+	return values
+}
+
+// withPartitionColumns appends a constant string column for each partition
+// value to table, releasing the original table.
+func withPartitionColumns(table arrow.Table, values []partitionValue) arrow.Table {
+	if len(values) == 0 {
+		return table
+	}
+	defer table.Release()
+
+	fields := table.Schema().Fields()
+	cols := make([]arrow.Column, 0, int(table.NumCols())+len(values))
+	for i := 0; i < int(table.NumCols()); i++ {
+		cols = append(cols, *table.Column(i))
+	}
+	for _, pv := range values {
+		b := array.NewStringBuilder(memory.DefaultAllocator)
+		for i := int64(0); i < table.NumRows(); i++ {
+			b.Append(pv.value)
+		}
+		arr := b.NewArray()
+		b.Release()
+		field := arrow.Field{Name: pv.column, Type: arrow.BinaryTypes.String}
+		fields = append(fields, field)
+		cols = append(cols, arrow.NewColumnFromArr(field, arr))
+		arr.Release()
+	}
+	result := array.NewTable(arrow.NewSchema(fields, nil), cols, table.NumRows())
+	for i := int(table.NumCols()); i < len(cols); i++ {
+		cols[i].Release()
+	}
+	return result
+}
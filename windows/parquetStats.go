@@ -0,0 +1,116 @@
+package windows
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/metadata"
+)
+
+var parquetStatsHeader = []string{"file", "row_group", "rows", "column", "physical_type", "values", "nulls", "distinct", "min", "max"}
+
+// ShowParquetStats opens a tab with the column statistics of every row group
+// of the Parquet files behind the current tab. They are read from the file
+// footers without reading any data.
+func (t *DataBrowser) ShowParquetStats() {
+	_, dataItem := t.currentTable()
+	if dataItem == nil {
+		return
+	}
+	if dataItem.watch == nil || dataItem.watch.interval > 0 {
+		t.showError(fmt.Errorf("%s was not loaded from local Parquet files", dataItem.source))
+		return
+	}
+	paths, err := parquetFiles(dataItem.watch.path)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	stats := Data{header: parquetStatsHeader, source: dataItem.source}
+	for _, path := range paths {
+		rows, err := parquetStatsRows(path)
+		if err != nil {
+			t.showError(fmt.Errorf("%s: %w", path, err))
+			return
+		}
+		rel, err := filepath.Rel(dataItem.watch.path, path)
+		if err != nil {
+			rel = path
+		}
+		for _, row := range rows {
+			stats.data = append(stats.data, append([]string{rel}, row...))
+		}
+	}
+	t.CreateDataBrowser(&stats, t.innerTabs.Selected().Text+" stats")
+}
+
+// parquetStatsRows describes each column chunk of each row group of path.
+// Values of columns without statistics are left empty.
+func parquetStatsRows(path string) ([][]string, error) {
+	rdr, err := file.OpenParquetFile(path, false)
+	if err != nil {
+		return nil, err
+	}
+	defer rdr.Close()
+	var rows [][]string
+	for g := 0; g < rdr.NumRowGroups(); g++ {
+		rg := rdr.MetaData().RowGroup(g)
+		for c := 0; c < rg.NumColumns(); c++ {
+			chunk, err := rg.ColumnChunk(c)
+			if err != nil {
+				return nil, err
+			}
+			row := []string{strconv.Itoa(g), strconv.FormatInt(rg.NumRows(), 10),
+				chunk.PathInSchema().String(), chunk.Type().String(), strconv.FormatInt(chunk.NumValues(), 10), "", "", "", ""}
+			if ok, _ := chunk.StatsSet(); ok {
+				if s, err := chunk.Statistics(); err == nil {
+					if s.HasNullCount() {
+						row[5] = strconv.FormatInt(s.NullCount(), 10)
+					}
+					if s.HasDistinctCount() {
+						row[6] = strconv.FormatInt(s.DistinctCount(), 10)
+					}
+					if s.HasMinMax() {
+						row[7], row[8] = statsMinMax(s)
+					}
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// statsMinMax formats the minimum and maximum of s. Values are physical, so
+// a timestamp shows as the integer it is stored as. Byte arrays show as text
+// when they are valid UTF-8 and as hex otherwise.
+func statsMinMax(s metadata.TypedStatistics) (string, string) {
+	switch s := s.(type) {
+	case *metadata.BooleanStatistics:
+		return strconv.FormatBool(s.Min()), strconv.FormatBool(s.Max())
+	case *metadata.Int32Statistics:
+		return strconv.FormatInt(int64(s.Min()), 10), strconv.FormatInt(int64(s.Max()), 10)
+	case *metadata.Int64Statistics:
+		return strconv.FormatInt(s.Min(), 10), strconv.FormatInt(s.Max(), 10)
+	case *metadata.Float32Statistics:
+		return strconv.FormatFloat(float64(s.Min()), 'g', -1, 32), strconv.FormatFloat(float64(s.Max()), 'g', -1, 32)
+	case *metadata.Float64Statistics:
+		return strconv.FormatFloat(s.Min(), 'g', -1, 64), strconv.FormatFloat(s.Max(), 'g', -1, 64)
+	case *metadata.ByteArrayStatistics:
+		return statsBytes(s.Min()), statsBytes(s.Max())
+	case *metadata.FixedLenByteArrayStatistics:
+		return statsBytes(s.Min()), statsBytes(s.Max())
+	}
+	return fmt.Sprint(s.EncodeMin()), fmt.Sprint(s.EncodeMax())
+}
+
+func statsBytes(b []byte) string {
+	if utf8.Valid(b) {
+		return string(b)
+	}
+	return "0x" + hex.EncodeToString(b)
+}
@@ -0,0 +1,172 @@
+package windows
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+var errNotTabular = errors.New("clipboard does not contain CSV, TSV or JSON table data")
+
+// PasteAsTable reads the clipboard, parses it as CSV, TSV or JSON and opens
+// the result in a new browser tab.
+func (t *DataBrowser) PasteAsTable() error {
+	data, err := parseTabularText(t.w.Clipboard().Content())
+	if err != nil {
+		return err
+	}
+	t.showTextTable(data, "clipboard", "Clipboard")
+	return nil
+}
+
+// OpenJSONText parses text as a JSON table and opens it in a new tab.
+func (t *DataBrowser) OpenJSONText(text, source string) error {
+	data, err := parseJSONTable(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+	t.showTextTable(data, source, source)
+	return nil
+}
+
+// showTextTable opens a table parsed from text, inferring column types when
+// that is enabled in the settings.
+func (t *DataBrowser) showTextTable(data Data, source, name string) {
+	data.source = source
+	if fyne.CurrentApp().Preferences().BoolWithFallback(inferTypesPreference, true) {
+		data.fields = inferFields(data.header, data.data)
+	}
+	t.CreateDataBrowser(&data, name)
+}
+
+// parseTabularText sniffs the content of text and parses it with the
+// matching reader. JSON arrays, JSON objects and newline delimited JSON are
+// recognised by their leading bracket, everything else is read as delimited
+// text.
+func parseTabularText(text string) (Data, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Data{}, errNotTabular
+	}
+	if text[0] == '[' || text[0] == '{' {
+		return parseJSONTable(text)
+	}
+	return parseDelimitedTable(text, sniffDelimiter(text))
+}
+
+// sniffDelimiter picks the separator of the first line. Tabs win since that
+// is what spreadsheets put on the clipboard.
+func sniffDelimiter(text string) rune {
+	line, _, _ := strings.Cut(text, "\n")
+	switch {
+	case strings.Contains(line, "\t"):
+		return '\t'
+	case strings.Count(line, ";") > strings.Count(line, ","):
+		return ';'
+	default:
+		return ','
+	}
+}
+
+func parseDelimitedTable(text string, delimiter rune) (Data, error) {
+	r := csv.NewReader(strings.NewReader(text))
+	r.Comma = delimiter
+	r.LazyQuotes = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return Data{}, fmt.Errorf("%w: %v", errNotTabular, err)
+	}
+	if len(records) < 2 || len(records[0]) < 2 {
+		return Data{}, errNotTabular
+	}
+	return Data{header: records[0], data: records[1:]}, nil
+}
+
+func parseJSONTable(text string) (Data, error) {
+	dec := json.NewDecoder(strings.NewReader(text))
+	var rows []map[string]string
+	var header []string
+	seen := make(map[string]bool)
+
+	readObject := func() error {
+		row := make(map[string]string)
+		if tok, err := dec.Token(); err != nil {
+			return err
+		} else if tok != json.Delim('{') {
+			return fmt.Errorf("expected an object, got %v", tok)
+		}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key := tok.(string)
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+			row[key] = jsonCellText(raw)
+			if !seen[key] {
+				seen[key] = true
+				header = append(header, key)
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		rows = append(rows, row)
+		return nil
+	}
+
+	if text[0] == '[' {
+		if _, err := dec.Token(); err != nil {
+			return Data{}, fmt.Errorf("%w: %v", errNotTabular, err)
+		}
+		for dec.More() {
+			if err := readObject(); err != nil {
+				return Data{}, fmt.Errorf("%w: %v", errNotTabular, err)
+			}
+		}
+	} else {
+		for dec.More() {
+			if err := readObject(); err != nil {
+				return Data{}, fmt.Errorf("%w: %v", errNotTabular, err)
+			}
+		}
+	}
+	if len(rows) == 0 || len(header) == 0 {
+		return Data{}, errNotTabular
+	}
+
+	data := Data{header: header, data: make([][]string, 0, len(rows))}
+	for _, row := range rows {
+		v := make([]string, len(header))
+		for i, key := range header {
+			v[i] = row[key]
+		}
+		data.data = append(data.data, v)
+	}
+	return data, nil
+}
+
+// jsonCellText renders a JSON value for display: strings are unquoted, null
+// is shown as an empty cell and anything else keeps its compact JSON form.
+func jsonCellText(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	if string(raw) == "null" {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
@@ -0,0 +1,156 @@
+package windows
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// pivotNullColumn names the column of rows whose column field is null.
+const pivotNullColumn = "(null)"
+
+// maxPivotColumns is the most value columns a pivot can have.
+const maxPivotColumns = 1000
+
+// pivotData crosstabs the loaded rows of d: one row per distinct value of
+// rowCol, one column per distinct value of colCol and in each cell the
+// aggregate fn of valCol over the rows with that pair of values. Pairs that
+// do not occur are null. Null values of rowCol make a row of their own,
+// apart from empty ones. More than maxPivotColumns distinct values of
+// colCol are an error.
+func pivotData(d *Data, rowCol, colCol, valCol int, fn string) (Data, error) {
+	if fn != "count" {
+		if _, ok := numericColumn(d, valCol); !ok {
+			return Data{}, fmt.Errorf("%s is not numeric, only count can be used", d.header[valCol])
+		}
+	}
+	type rowKey struct {
+		value string
+		null  bool
+	}
+	var rowKeys []rowKey
+	rowIndex := make(map[rowKey]int)
+	colIndex := make(map[string]int)
+	var colKeys []string
+	type cell struct {
+		row rowKey
+		col string
+	}
+	values := make(map[cell][]float64)
+	counts := make(map[cell]int)
+	for row := range d.data {
+		r := rowKey{value: d.cellValue(row, rowCol), null: d.isNull(row, rowCol)}
+		if r.null {
+			r.value = ""
+		}
+		if _, ok := rowIndex[r]; !ok {
+			rowIndex[r] = len(rowKeys)
+			rowKeys = append(rowKeys, r)
+		}
+		c := d.cellValue(row, colCol)
+		if d.isNull(row, colCol) {
+			c = pivotNullColumn
+		}
+		if _, ok := colIndex[c]; !ok {
+			if len(colKeys) == maxPivotColumns {
+				return Data{}, fmt.Errorf("%s has more than %d distinct values, too many to pivot into columns", d.header[colCol], maxPivotColumns)
+			}
+			colIndex[c] = len(colKeys)
+			colKeys = append(colKeys, c)
+		}
+		key := cell{r, c}
+		if _, ok := counts[key]; !ok {
+			counts[key] = 0
+		}
+		if d.isNull(row, valCol) {
+			continue
+		}
+		counts[key]++
+		if fn != "count" {
+			v, _ := d.format.parseFloat(d.data[row][valCol])
+			values[key] = append(values[key], v)
+		}
+	}
+	sort.Strings(colKeys)
+
+	result := Data{header: append([]string{d.header[rowCol]}, colKeys...), format: d.format}
+	valueType := arrow.DataType(arrow.PrimitiveTypes.Float64)
+	if fn == "count" {
+		valueType = arrow.PrimitiveTypes.Int64
+	}
+	rowType := arrow.DataType(arrow.BinaryTypes.String)
+	if rowCol < len(d.fields) {
+		rowType = d.fields[rowCol].Type
+	}
+	result.fields = []arrow.Field{{Name: d.header[rowCol], Type: rowType, Nullable: true}}
+	for _, c := range colKeys {
+		result.fields = append(result.fields, arrow.Field{Name: c, Type: valueType, Nullable: true})
+	}
+	for _, r := range rowKeys {
+		out := make([]string, len(result.header))
+		nulls := make([]bool, len(result.header))
+		out[0], nulls[0] = r.value, r.null
+		for i, c := range colKeys {
+			n, ok := counts[cell{r, c}]
+			switch {
+			case !ok:
+				nulls[i+1] = true
+			case fn == "count":
+				out[i+1] = strconv.Itoa(n)
+			case n == 0:
+				nulls[i+1] = true
+			default:
+				out[i+1] = d.format.float(aggregateValues(values[cell{r, c}], fn), -1)
+			}
+		}
+		result.data = append(result.data, out)
+		result.nulls = append(result.nulls, nulls)
+	}
+	return result, nil
+}
+
+// Pivot asks for the fields of a crosstab of the current table and opens
+// it in a new tab, from where it can be exported like any other table.
+func (t *DataBrowser) Pivot() {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
+	}
+	t.mu.Lock()
+	header := slices.Clone(dataItem.header)
+	t.mu.Unlock()
+	rows := widget.NewSelect(header, nil)
+	columns := widget.NewSelect(header, nil)
+	values := widget.NewSelect(header, nil)
+	fn := widget.NewSelect(aggregateFuncs, nil)
+	fn.Selected = "sum"
+	dialog.NewForm("Pivot", "Pivot", "Cancel", []*widget.FormItem{
+		widget.NewFormItem("Rows", rows),
+		{Text: "Columns", Widget: columns, HintText: "One column per distinct value"},
+		widget.NewFormItem("Values", values),
+		widget.NewFormItem("Aggregate", fn),
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		if rows.SelectedIndex() < 0 || columns.SelectedIndex() < 0 || values.SelectedIndex() < 0 {
+			t.showError(fmt.Errorf("choose the rows, columns and values of the pivot"))
+			return
+		}
+		t.mu.Lock()
+		pivot, err := pivotData(dataItem, rows.SelectedIndex(), columns.SelectedIndex(), values.SelectedIndex(), fn.Selected)
+		t.mu.Unlock()
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		pivot.source = dataItem.source
+		pivot.profile = dataItem.profile
+		t.CreateDataBrowser(&pivot, t.innerTabs.Selected().Text+" pivot")
+	}, t.w).Show()
+}
@@ -0,0 +1,63 @@
+package windows
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestPivotNullRowKeys(t *testing.T) {
+	d := &Data{
+		header: []string{"region", "year", "sales"},
+		data: [][]string{
+			{"north", "2023", "1"},
+			{"", "2023", "2"},
+			{"", "2024", "3"},
+			{"", "2024", "4"},
+		},
+		nulls: [][]bool{
+			{false, false, false},
+			{false, false, false},
+			{true, false, false},
+			{true, false, false},
+		},
+	}
+	pivot, err := pivotData(d, 0, 1, 2, "count")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"region", "2023", "2024"}; !slices.Equal(pivot.header, want) {
+		t.Errorf("header is %v, want %v", pivot.header, want)
+	}
+	want := []struct {
+		key  string
+		null bool
+		row  []string
+	}{
+		{"north", false, []string{"north", "1", ""}},
+		{"empty", false, []string{"", "1", ""}},
+		{"null", true, []string{"", "", "2"}},
+	}
+	if len(pivot.data) != len(want) {
+		t.Fatalf("pivot has %d rows, want %d", len(pivot.data), len(want))
+	}
+	for i, w := range want {
+		if !slices.Equal(pivot.data[i], w.row) || pivot.nulls[i][0] != w.null {
+			t.Errorf("%s row is %v with null key %v, want %v with null key %v", w.key, pivot.data[i], pivot.nulls[i][0], w.row, w.null)
+		}
+	}
+}
+
+func TestPivotColumnLimit(t *testing.T) {
+	d := &Data{header: []string{"id", "key", "value"}}
+	for i := 0; i <= maxPivotColumns; i++ {
+		d.data = append(d.data, []string{"1", strconv.Itoa(i), "1"})
+	}
+	if _, err := pivotData(d, 0, 1, 2, "count"); err == nil {
+		t.Errorf("pivot into %d columns succeeded, want an error", maxPivotColumns+1)
+	}
+	d.data = d.data[:maxPivotColumns]
+	if _, err := pivotData(d, 0, 1, 2, "count"); err != nil {
+		t.Errorf("pivot into %d columns: %v", maxPivotColumns, err)
+	}
+}
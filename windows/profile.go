@@ -0,0 +1,147 @@
+package windows
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"gopkg.in/yaml.v3"
+)
+
+var envPlaceholder = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+var (
+	errEmptyProfile   = errors.New("the profile file is empty, open the .share file from your data provider")
+	errProfileJSON    = errors.New("the profile file is neither valid JSON nor YAML, check that it is an unedited .share file")
+	errNotProfileJSON = errors.New("the file is JSON but not a Delta Sharing profile")
+)
+
+// checkProfile tells apart the ways a file can fail to be a profile: it is
+// empty, it is not JSON, or it is JSON without the endpoint and bearer token
+// of a Delta Sharing profile.
+func checkProfile(profile string) error {
+	if strings.TrimSpace(profile) == "" {
+		return errEmptyProfile
+	}
+	var v any
+	if err := json.Unmarshal([]byte(profile), &v); err != nil {
+		return fmt.Errorf("%w: %v", errProfileJSON, err)
+	}
+	fields, ok := v.(map[string]any)
+	if !ok {
+		return errNotProfileJSON
+	}
+	var missing []string
+	for _, key := range []string{"endpoint", "bearerToken"} {
+		if s, _ := fields[key].(string); s == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: missing %s", errNotProfileJSON, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// yamlProfileToJSON converts a profile written in YAML to the JSON the
+// client expects. JSON stays the primary format: a profile that parses as
+// JSON, or that is not a YAML mapping either, is returned unchanged so
+// checkProfile can explain what is wrong with it.
+func yamlProfileToJSON(profile string, uri fyne.URI) string {
+	isYAML := uri != nil && (uri.Extension() == ".yaml" || uri.Extension() == ".yml")
+	if !isYAML && json.Valid([]byte(profile)) {
+		return profile
+	}
+	var fields map[string]any
+	if err := yaml.Unmarshal([]byte(profile), &fields); err != nil || len(fields) == 0 {
+		return profile
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return profile
+	}
+	return string(b)
+}
+
+// profileName is the name of the loaded profile file without its
+// extension, such as prod for prod.share, or "" if it was not read from a
+// file.
+func (t *MainWindow) profileName() string {
+	if t.profileURI == nil {
+		return ""
+	}
+	return strings.TrimSuffix(t.profileURI.Name(), t.profileURI.Extension())
+}
+
+// profileTabTitle prefixes the title of a table's tab with the profile it
+// was read from, so prod:events and staging:events can be told apart.
+func profileTabTitle(profile, name string) string {
+	if profile == "" {
+		return name
+	}
+	return profile + ":" + name
+}
+
+// expandProfileEnv replaces ${VAR} placeholders in a profile with the value
+// of the environment variable, so secrets such as the bearer token need not
+// be stored in the file. Values are escaped for use inside JSON strings.
+func expandProfileEnv(profile string) (string, error) {
+	var missing []string
+	expanded := envPlaceholder.ReplaceAllStringFunc(profile, func(m string) string {
+		name := envPlaceholder.FindStringSubmatch(m)[1]
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+			return m
+		}
+		b, _ := json.Marshal(v)
+		return string(b[1 : len(b)-1])
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("profile refers to unset environment variable %s", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
+
+// maskedProfile pretty prints profile with all but the last four characters
+// of the bearer token replaced, and returns its endpoint.
+func maskedProfile(profile string) (string, string, error) {
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(profile), &fields); err != nil {
+		return "", "", err
+	}
+	if token, ok := fields["bearerToken"].(string); ok {
+		keep := min(4, len(token))
+		fields["bearerToken"] = strings.Repeat("*", len(token)-keep) + token[len(token)-keep:]
+	}
+	endpoint, _ := fields["endpoint"].(string)
+	b, err := json.MarshalIndent(fields, "", "  ")
+	return string(b), endpoint, err
+}
+
+// ShowProfile shows the loaded profile with its bearer token masked.
+func (t *MainWindow) ShowProfile() {
+	if t.profile == "" {
+		t.showError(errors.New("no profile is loaded"))
+		return
+	}
+	text, endpoint, err := maskedProfile(t.profile)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	view := widget.NewLabel(text)
+	view.TextStyle = fyne.TextStyle{Monospace: true}
+	copyEndpoint := widget.NewButtonWithIcon("Copy endpoint", theme.ContentCopyIcon(), func() {
+		t.w.Clipboard().SetContent(endpoint)
+	})
+	dialog.NewCustom("Profile", "Close", container.NewBorder(nil, copyEndpoint, nil, nil, view), t.w).Show()
+}
@@ -0,0 +1,116 @@
+package windows
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	recentTablesPreference = "recentTables"
+	maxRecentTables        = 10
+)
+
+// recentTable is an entry of the recently opened tables list. Profile is
+// the URI of the profile file the table was opened with.
+type recentTable struct {
+	Profile string `json:"profile"`
+	Share   string `json:"share"`
+	Schema  string `json:"schema"`
+	Table   string `json:"table"`
+}
+
+func (r recentTable) String() string {
+	return qualifiedName(r.Share, r.Schema, r.Table)
+}
+
+// recentTables returns the stored list, most recent first.
+func (t *MainWindow) recentTables() []recentTable {
+	var recent []recentTable
+	json.Unmarshal([]byte(t.a.Preferences().String(recentTablesPreference)), &recent)
+	return recent
+}
+
+// rememberTable moves the selected table to the front of the recent list.
+func (t *MainWindow) rememberTable() {
+	entry := recentTable{Share: t.selected.share, Schema: t.selected.schema, Table: t.selected.table_name}
+	if t.profileURI != nil {
+		entry.Profile = t.profileURI.String()
+	}
+	recent := slices.DeleteFunc(t.recentTables(), func(r recentTable) bool { return r == entry })
+	recent = append([]recentTable{entry}, recent...)
+	if len(recent) > maxRecentTables {
+		recent = recent[:maxRecentTables]
+	}
+	b, _ := json.Marshal(recent)
+	t.a.Preferences().SetString(recentTablesPreference, string(b))
+}
+
+// openRecentTable loads the profile of r if another one is open and then
+// opens the table through the navigation lists.
+func (t *MainWindow) openRecentTable(r recentTable) {
+	if r.Profile != "" && (t.profileURI == nil || t.profileURI.String() != r.Profile) {
+		uri, err := storage.ParseURI(r.Profile)
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		rc, err := storage.Reader(uri)
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		d, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		t.profileURI = uri
+		t.a.Preferences().SetString(lastProfilePreference, uri.String())
+		t.loadProfile(string(d))
+	}
+	t.selectTable(r.Share, r.Schema, r.Table)
+}
+
+// selectTable opens a table of the loaded profile by selecting it in the
+// navigation lists.
+func (t *MainWindow) selectTable(share, schema, table string) {
+	i := slices.Index(t.share, share)
+	if i < 0 {
+		t.showError(fmt.Errorf("share %s is not available", share))
+		return
+	}
+	t.shareWidget.Select(i)
+	j := slices.Index(t.schemas, schema)
+	if j < 0 {
+		t.showError(fmt.Errorf("schema %s is not available", qualifiedName(share, schema)))
+		return
+	}
+	t.schemaWidget.Select(j)
+	k := slices.Index(t.tables, table)
+	if k < 0 {
+		t.showError(fmt.Errorf("table %s is not available", qualifiedName(share, schema, table)))
+		return
+	}
+	t.tablesWidget.Select(k)
+}
+
+// ShowRecentTables shows the recently opened tables as a menu.
+func (t *MainWindow) ShowRecentTables() {
+	recent := t.recentTables()
+	if len(recent) == 0 {
+		t.status.SetText("No recently opened tables")
+		return
+	}
+	items := make([]*fyne.MenuItem, len(recent))
+	for i, r := range recent {
+		items[i] = fyne.NewMenuItem(r.String(), func() { t.openRecentTable(r) })
+	}
+	widget.ShowPopUpMenuAtPosition(fyne.NewMenu("", items...), t.w.Canvas(), fyne.NewPos(150, 40))
+}
@@ -0,0 +1,117 @@
+package windows
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// recordView shows one row of a table at a time as a list of labeled
+// fields, which is easier to read than the grid for very wide tables.
+type recordView struct {
+	content  *fyne.Container
+	fields   *fyne.Container
+	values   []*widget.Label
+	position *widget.Label
+	row      int
+	show     func(row int)
+}
+
+// recordValue is the text shown for a field. Nested values are indented
+// JSON so their structure is visible.
+func (d *Data) recordValue(row, col int) string {
+	v := d.displayValue(row, col)
+	if col >= len(d.fields) || d.isNull(row, col) {
+		return v
+	}
+	switch d.fields[col].Type.ID() {
+	case arrow.STRUCT, arrow.LIST, arrow.LARGE_LIST, arrow.MAP:
+		var b bytes.Buffer
+		if json.Indent(&b, []byte(v), "", "  ") == nil {
+			return b.String()
+		}
+	}
+	return v
+}
+
+// newRecordView creates the record view of a table, hidden until the tab
+// is switched to it.
+func (t *DataBrowser) newRecordView(table *widget.Table, dataItem *Data) *recordView {
+	r := &recordView{fields: container.New(layout.NewFormLayout()), position: widget.NewLabel("")}
+	show := func(row int) {
+		t.mu.Lock()
+		rows := len(dataItem.data)
+		header := slices.Clone(dataItem.header)
+		values := make([]string, len(header))
+		if rows > 0 {
+			r.row = max(0, min(row, rows-1))
+			for col := range values {
+				values[col] = dataItem.recordValue(r.row, col)
+			}
+		}
+		t.mu.Unlock()
+		if rows == 0 {
+			r.position.SetText("No records")
+			return
+		}
+		if len(r.values) != len(header) {
+			r.fields.Objects = nil
+			r.values = make([]*widget.Label, len(header))
+			for col, name := range header {
+				label := widget.NewLabel(name)
+				label.TextStyle = fyne.TextStyle{Bold: true}
+				r.values[col] = widget.NewLabel("")
+				r.values[col].Wrapping = fyne.TextWrapWord
+				r.fields.Objects = append(r.fields.Objects, label, r.values[col])
+			}
+		}
+		for col, label := range r.values {
+			label.SetText(values[col])
+		}
+		r.fields.Refresh()
+		r.position.SetText(fmt.Sprintf("Record %d of %d", r.row+1, rows))
+		if r.row >= rows-loadMoreThreshold {
+			t.loadMore(table, dataItem)
+		}
+	}
+	nav := container.NewHBox(
+		widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() { show(r.row - 1) }),
+		r.position,
+		widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() { show(r.row + 1) }))
+	r.content = container.NewBorder(nav, nil, nil, nil, container.NewVScroll(r.fields))
+	r.content.Hide()
+	r.show = show
+	return r
+}
+
+// newRecordViewCheck switches a tab between the grid and the record view,
+// which opens at the selected row and selects the row shown when closed.
+func (t *DataBrowser) newRecordViewCheck(table *widget.Table, dataItem *Data, grid fyne.CanvasObject, r *recordView) *widget.Check {
+	return widget.NewCheck("Record view", func(on bool) {
+		if on {
+			r.show(t.selection[table].Row)
+			grid.Hide()
+			r.content.Show()
+			return
+		}
+		r.content.Hide()
+		grid.Show()
+		t.mu.Lock()
+		empty := len(dataItem.data) == 0
+		t.mu.Unlock()
+		if empty {
+			return
+		}
+		id := widget.TableCellID{Row: r.row, Col: t.selection[table].Col}
+		table.ScrollTo(id)
+		table.Select(id)
+	})
+}
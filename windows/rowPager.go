@@ -0,0 +1,207 @@
+package windows
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+)
+
+const (
+	// pageSize is the number of rows converted for display per batch.
+	pageSize = 1000
+	// loadMoreThreshold is how close to the last loaded row a rendered
+	// cell must be to fetch the next batch.
+	loadMoreThreshold = 50
+)
+
+// rowPager hands out the rows of a table batch by batch: first the batches
+// of the loaded Arrow table, then those of the table's remaining files,
+// which are only downloaded once the previous one is used up.
+type rowPager struct {
+	// mu guards the reader for the time it takes to hand out one batch, so
+	// the pager can be closed between batches, and the loading flags.
+	mu        sync.Mutex
+	table     arrow.Table
+	reader    *array.TableReader
+	files     []string
+	load      func(fileID string) (arrow.Table, error)
+	loading   bool
+	cancel    bool
+	done      bool
+	indicator *widget.Label
+	banner    *truncationBanner
+}
+
+// next returns the next batch of rows, or nil once all files are read or
+// the pager is closed. The caller owns the record and must release it, so
+// it stays valid when the pager is closed while it is converted. An error
+// ends the pager. The lock is released while a file is downloaded, so close
+// does not wait for the download; its table is dropped once it arrives. It
+// must not be called again before it returns.
+func (p *rowPager) next() (arrow.Record, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for {
+		if p.done {
+			return nil, nil
+		}
+		if p.reader != nil && p.reader.Next() {
+			rec := p.reader.Record()
+			rec.Retain()
+			return rec, nil
+		}
+		p.release()
+		if len(p.files) == 0 || p.load == nil {
+			p.done = true
+			return nil, nil
+		}
+		fileID := p.files[0]
+		p.files = p.files[1:]
+		p.mu.Unlock()
+		table, err := p.load(fileID)
+		p.mu.Lock()
+		if p.done {
+			if table != nil {
+				table.Release()
+			}
+			return nil, nil
+		}
+		if err != nil {
+			p.done = true
+			return nil, err
+		}
+		p.table = table
+		p.reader = array.NewTableReader(table, pageSize)
+	}
+}
+
+// begin marks the pager as loading in the background. It reports false
+// when a load is already running or all rows have been handed out.
+func (p *rowPager) begin() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.loading || p.done {
+		return false
+	}
+	p.loading = true
+	p.cancel = false
+	return true
+}
+
+// cancelLoad asks a running background load to stop after its current
+// batch. Rows can still be read later.
+func (p *rowPager) cancelLoad() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cancel = p.loading
+}
+
+// loadCancelled reports whether cancelLoad was called since begin.
+func (p *rowPager) loadCancelled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.cancel
+}
+
+// end marks the load started by begin as finished.
+func (p *rowPager) end() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.loading = false
+}
+
+// finished reports whether all rows have been handed out.
+func (p *rowPager) finished() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.done
+}
+
+// release frees the Arrow table currently being read.
+func (p *rowPager) release() {
+	if p.reader != nil {
+		p.reader.Release()
+		p.reader = nil
+	}
+	if p.table != nil {
+		p.table.Release()
+		p.table = nil
+	}
+}
+
+// close releases the pager's Arrow memory and stops further loads. Batches
+// already handed out stay valid until their caller releases them.
+func (p *rowPager) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.release()
+	p.files = nil
+	p.done = true
+}
+
+// pagerOf returns the pager of dataItem, which is replaced when the table
+// is reloaded.
+func (t *DataBrowser) pagerOf(dataItem *Data) *rowPager {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return dataItem.pager
+}
+
+// loadMore appends the next batch of rows to dataItem in the background,
+// showing the pager's indicator while it runs. The batch is converted
+// under the browser lock, so tables being drawn never see it half added.
+// A batch read while the table was reloaded is dropped.
+func (t *DataBrowser) loadMore(table *widget.Table, dataItem *Data) {
+	p := t.pagerOf(dataItem)
+	if p == nil || !p.begin() {
+		return
+	}
+	if p.indicator != nil {
+		p.indicator.Show()
+	}
+	go func() {
+		defer func() {
+			p.end()
+			if p.indicator != nil {
+				p.indicator.Hide()
+			}
+		}()
+		rec, err := p.next()
+		if err != nil {
+			t.showError(err)
+			return
+		}
+		if rec == nil {
+			return
+		}
+		t.mu.Lock()
+		evolution := ""
+		if dataItem.pager == p {
+			evolution = dataItem.appendRecord(rec)
+		}
+		t.mu.Unlock()
+		rec.Release()
+		if evolution != "" {
+			t.setStatus(evolution)
+		}
+		t.updateRowHeights(table, dataItem)
+		t.updateTruncation(dataItem)
+		table.Refresh()
+	}()
+}
+
+// Close releases the Arrow memory held by all open tables and removes the
+// temporary files written for them.
+func (t *DataBrowser) Close() {
+	t.removeTempFiles()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, dataItem := range t.tables {
+		if dataItem.pager != nil {
+			dataItem.pager.close()
+		}
+		dataItem.arrow_table = nil
+	}
+}
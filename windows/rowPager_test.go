@@ -0,0 +1,120 @@
+package windows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// testTable builds a table of n int64 rows allocated from mem.
+func testTable(mem memory.Allocator, n int) arrow.Table {
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+	for i := 0; i < n; i++ {
+		b.Append(int64(i))
+	}
+	arr := b.NewArray()
+	defer arr.Release()
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	col := arrow.NewColumnFromArr(schema.Field(0), arr)
+	defer col.Release()
+	return array.NewTable(schema, []arrow.Column{col}, int64(n))
+}
+
+func newTestPager(mem memory.Allocator, files []string, load func(string) (arrow.Table, error)) *rowPager {
+	first := testTable(mem, 2*pageSize+10)
+	return &rowPager{
+		table:  first,
+		reader: array.NewTableReader(first, pageSize),
+		files:  files,
+		load:   load,
+	}
+}
+
+func TestRowPagerReadsAllFiles(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	p := newTestPager(mem, []string{"a", "b"}, func(string) (arrow.Table, error) {
+		return testTable(mem, 5), nil
+	})
+	rows := 0
+	for {
+		rec, err := p.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rec == nil {
+			break
+		}
+		rows += int(rec.NumRows())
+		rec.Release()
+	}
+	if want := 2*pageSize + 10 + 2*5; rows != want {
+		t.Errorf("read %d rows, want %d", rows, want)
+	}
+	if !p.finished() {
+		t.Error("pager not finished after the last file")
+	}
+	p.close()
+}
+
+func TestRowPagerCloseReleasesMemory(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	p := newTestPager(mem, []string{"a"}, func(string) (arrow.Table, error) {
+		return testTable(mem, 5), nil
+	})
+	rec, err := p.next()
+	if err != nil || rec == nil {
+		t.Fatalf("next() = %v, %v", rec, err)
+	}
+	p.close()
+	// A batch handed out before the close stays valid until released.
+	if got := rec.Column(0).(*array.Int64).Value(1); got != 1 {
+		t.Errorf("value after close = %d, want 1", got)
+	}
+	rec.Release()
+	if rec, _ := p.next(); rec != nil {
+		t.Error("next() returned a batch after close")
+	}
+}
+
+func TestRowPagerCloseDuringLoad(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	p := &rowPager{files: []string{"a"}, load: func(string) (arrow.Table, error) {
+		close(started)
+		<-unblock
+		return testTable(mem, 5), nil
+	}}
+	type result struct {
+		rec arrow.Record
+		err error
+	}
+	results := make(chan result)
+	go func() {
+		rec, err := p.next()
+		results <- result{rec, err}
+	}()
+	<-started
+
+	closed := make(chan struct{})
+	go func() {
+		p.close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("close waited for the file being loaded")
+	}
+	close(unblock)
+	if r := <-results; r.rec != nil || r.err != nil {
+		t.Errorf("next() after close = %v, %v, want nil, nil", r.rec, r.err)
+	}
+}
@@ -0,0 +1,46 @@
+package windows
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// unionSchema adds the columns of schema that the table does not have yet,
+// as null in the rows already loaded, so files written after a column was
+// added still show it. It describes the added columns and those whose type
+// differs from the table's, or returns an empty string if there are none.
+func (d *Data) unionSchema(schema *arrow.Schema) string {
+	pos := columnPositions(d.header)
+	var added, changed []string
+	for _, f := range schema.Fields() {
+		i, ok := pos[f.Name]
+		if !ok {
+			d.header = append(d.header, f.Name)
+			if len(d.fields) == len(d.header)-1 {
+				d.fields = append(d.fields, f)
+			}
+			for row := range d.data {
+				d.data[row] = append(d.data[row], "")
+				d.nulls[row] = append(d.nulls[row], true)
+			}
+			added = append(added, f.Name)
+			continue
+		}
+		if i < len(d.fields) && !arrow.TypeEqual(d.fields[i].Type, f.Type) {
+			changed = append(changed, fmt.Sprintf("%s (%s, was %s)", f.Name, f.Type, d.fields[i].Type))
+		}
+	}
+	var parts []string
+	if len(added) > 0 {
+		parts = append(parts, "added "+strings.Join(added, ", "))
+	}
+	if len(changed) > 0 {
+		parts = append(parts, "changed type of "+strings.Join(changed, ", "))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "Schema evolution in " + d.source + ": " + strings.Join(parts, "; ")
+}
@@ -0,0 +1,85 @@
+package windows
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// parquetFieldIDKey is the field metadata key under which Parquet field IDs
+// are kept when a Parquet schema is read into Arrow.
+const parquetFieldIDKey = "PARQUET:field_id"
+
+// schemaFieldJSON is a field of the full schema export.
+type schemaFieldJSON struct {
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`
+	Nullable bool              `json:"nullable"`
+	FieldID  *int              `json:"fieldId,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Children []schemaFieldJSON `json:"children,omitempty"`
+}
+
+func fieldJSON(f arrow.Field) schemaFieldJSON {
+	out := schemaFieldJSON{Name: f.Name, Type: f.Type.String(), Nullable: f.Nullable}
+	keys, values := f.Metadata.Keys(), f.Metadata.Values()
+	if len(keys) > 0 {
+		out.Metadata = make(map[string]string, len(keys))
+		for i, k := range keys {
+			out.Metadata[k] = values[i]
+		}
+	}
+	if v, ok := out.Metadata[parquetFieldIDKey]; ok {
+		if id, err := strconv.Atoi(v); err == nil {
+			out.FieldID = &id
+		}
+	}
+	for _, child := range childFields(f.Type) {
+		out.Children = append(out.Children, fieldJSON(child))
+	}
+	return out
+}
+
+// writeSchemaJSON writes fields with their types, metadata and field IDs,
+// nested fields included, as indented JSON.
+func writeSchemaJSON(w io.Writer, fields []arrow.Field) error {
+	out := make([]schemaFieldJSON, len(fields))
+	for i, f := range fields {
+		out[i] = fieldJSON(f)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// ExportSchema saves the full Arrow schema of the current table as JSON,
+// for reconciling it with the schema of another system.
+func (t *DataBrowser) ExportSchema() {
+	_, dataItem := t.currentTable()
+	if dataItem == nil {
+		return
+	}
+	t.mu.Lock()
+	fields := dataItem.fields
+	t.mu.Unlock()
+	if len(fields) == 0 {
+		t.showError(fmt.Errorf("%s has no Arrow schema", dataItem.source))
+		return
+	}
+	d := dialog.NewFileSave(func(uc fyne.URIWriteCloser, err error) {
+		if err != nil || uc == nil {
+			return
+		}
+		defer uc.Close()
+		if err := writeSchemaJSON(uc, fields); err != nil {
+			t.showError(err)
+		}
+	}, t.w)
+	d.SetFileName("schema.json")
+	d.Show()
+}
@@ -0,0 +1,50 @@
+package windows
+
+import (
+	"context"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	delta_sharing "github.com/magpierre/go_delta_sharing_client"
+)
+
+var schemaOnlyHeader = []string{"name", "type", "nullable", "comment"}
+
+// sparkComment returns the comment in the metadata of a Spark schema field.
+func sparkComment(metadata interface{}) string {
+	m, _ := metadata.(map[string]interface{})
+	comment, _ := m["comment"].(string)
+	return comment
+}
+
+// LoadSchemaOnly opens the columns of a table in a new tab, one row per
+// column, from the table metadata without reading any of its data.
+func (t *MainWindow) LoadSchemaOnly(share, schema, name string) {
+	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), t.profile, "")
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	metadata, err := ds.GetTableMetadata(delta_sharing.Table{Share: share, Schema: schema, Name: name})
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	spark, err := metadata.GetSparkSchema()
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	columns := Data{header: schemaOnlyHeader, source: qualifiedName(share, schema, name), profile: t.profileName()}
+	for _, f := range spark.Fields {
+		columns.data = append(columns.data, []string{f.Name, sparkTypeString(f.Type), strconv.FormatBool(f.Nullable), sparkComment(f.Metadata)})
+	}
+	t.browser().CreateDataBrowser(&columns, profileTabTitle(t.profileName(), name)+" schema")
+}
+
+// schemaOnlyMenuItem offers to load only the columns of the table at parts.
+func (t *MainWindow) schemaOnlyMenuItem(parts []string) *fyne.MenuItem {
+	return fyne.NewMenuItem("Load Schema Only", func() {
+		t.LoadSchemaOnly(parts[0], parts[1], parts[2])
+	})
+}
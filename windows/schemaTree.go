@@ -0,0 +1,117 @@
+package windows
+
+import (
+	"image/color"
+	"slices"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+const showSchemaPreference = "showSchemaTree"
+
+// SetShowSchema shows or hides the schema tree beside every table.
+func (t *DataBrowser) SetShowSchema(show bool) {
+	t.showSchema = show
+	fyne.CurrentApp().Preferences().SetBool(showSchemaPreference, show)
+	for _, panel := range t.schemaTrees {
+		if show {
+			panel.Show()
+		} else {
+			panel.Hide()
+		}
+	}
+}
+
+// childFields returns the fields nested in a struct, list or map type.
+func childFields(dt arrow.DataType) []arrow.Field {
+	switch dt := dt.(type) {
+	case *arrow.StructType:
+		return dt.Fields()
+	case arrow.ListLikeType:
+		return []arrow.Field{dt.ElemField()}
+	}
+	return nil
+}
+
+// schemaField resolves a tree node id, the slash separated indexes of the
+// field at each level, to its field.
+func schemaField(fields []arrow.Field, uid widget.TreeNodeID) (arrow.Field, bool) {
+	var f arrow.Field
+	for _, part := range strings.Split(uid, "/") {
+		i, err := strconv.Atoi(part)
+		if err != nil || i >= len(fields) {
+			return arrow.Field{}, false
+		}
+		f = fields[i]
+		fields = childFields(f.Type)
+	}
+	return f, true
+}
+
+// newSchemaTree shows the Arrow schema of dataItem as a tree in a side
+// panel. Selecting a top level field scrolls its column into view.
+func (t *DataBrowser) newSchemaTree(table *widget.Table, dataItem *Data) fyne.CanvasObject {
+	// Fields are only ever appended, so a snapshot of the slice stays valid.
+	fields := func() []arrow.Field {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		return dataItem.fields
+	}
+	children := func(uid widget.TreeNodeID) []arrow.Field {
+		if uid == "" {
+			return fields()
+		}
+		f, _ := schemaField(fields(), uid)
+		return childFields(f.Type)
+	}
+	tree := widget.NewTree(func(uid widget.TreeNodeID) []widget.TreeNodeID {
+		fields := children(uid)
+		ids := make([]widget.TreeNodeID, len(fields))
+		for i := range fields {
+			ids[i] = strconv.Itoa(i)
+			if uid != "" {
+				ids[i] = uid + "/" + ids[i]
+			}
+		}
+		return ids
+	}, func(uid widget.TreeNodeID) bool {
+		return uid == "" || len(children(uid)) > 0
+	}, func(bool) fyne.CanvasObject {
+		return widget.NewLabel("template")
+	}, func(uid widget.TreeNodeID, branch bool, co fyne.CanvasObject) {
+		f, _ := schemaField(fields(), uid)
+		text := f.Name + ": " + f.Type.String()
+		if branch {
+			text = f.Name + ": " + f.Type.Name()
+		}
+		co.(*widget.Label).SetText(text)
+	})
+	tree.OnSelected = func(uid widget.TreeNodeID) {
+		top, _, _ := strings.Cut(uid, "/")
+		f, ok := schemaField(fields(), top)
+		if !ok {
+			return
+		}
+		t.mu.Lock()
+		col := slices.Index(dataItem.header, f.Name)
+		t.mu.Unlock()
+		if col >= 0 {
+			id := widget.TableCellID{Row: t.selection[table].Row, Col: col}
+			table.ScrollTo(id)
+			table.Select(id)
+		}
+	}
+	width := canvas.NewRectangle(color.Transparent)
+	width.SetMinSize(fyne.NewSize(220, 0))
+	panel := container.NewStack(width, tree)
+	if !t.showSchema {
+		panel.Hide()
+	}
+	return panel
+}
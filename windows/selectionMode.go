@@ -0,0 +1,79 @@
+package windows
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+const selectionModePreference = "selectionMode"
+
+// Selection modes of a data table. In row mode the whole row of the selected
+// cell is highlighted and copied; in cell mode only the cell is.
+const (
+	selectionModeCell = "Cell"
+	selectionModeRow  = "Row"
+)
+
+var selectionModes = []string{selectionModeCell, selectionModeRow}
+
+// defaultSelectionMode is the mode new tables start in.
+func defaultSelectionMode() string {
+	return fyne.CurrentApp().Preferences().StringWithFallback(selectionModePreference, selectionModeCell)
+}
+
+// isSelectedRow reports whether row is highlighted as the selected row of
+// table.
+func (t *DataBrowser) isSelectedRow(table *widget.Table, dataItem *Data, row int) bool {
+	id, ok := t.selection[table]
+	return ok && dataItem.selectMode == selectionModeRow && id.Row == row
+}
+
+// newSelectionModeSelect switches the selection mode of a single table
+// without changing the default for new ones.
+func (t *DataBrowser) newSelectionModeSelect(table *widget.Table, dataItem *Data) *widget.Select {
+	modes := widget.NewSelect(selectionModes, func(mode string) {
+		t.mu.Lock()
+		dataItem.selectMode = mode
+		summary := ""
+		id, ok := t.selection[table]
+		if ok {
+			summary = selectionSummary(dataItem, id)
+		}
+		t.mu.Unlock()
+		if ok {
+			t.setStatus(summary)
+		}
+		table.Refresh()
+	})
+	modes.Selected = dataItem.selectMode
+	return modes
+}
+
+// CopySelection puts the selected cell of the current table on the
+// clipboard, or in row mode the whole row as tab separated values.
+func (t *DataBrowser) CopySelection() {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
+	}
+	id, ok := t.selection[table]
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !ok || id.Row >= len(dataItem.data) {
+		return
+	}
+	if dataItem.selectMode != selectionModeRow {
+		t.w.Clipboard().SetContent(dataItem.cellValue(id.Row, id.Col))
+		t.setStatus(fmt.Sprintf("Copied %s of row %d", dataItem.header[id.Col], id.Row+1))
+		return
+	}
+	values := make([]string, len(dataItem.header))
+	for col := range values {
+		values[col] = dataItem.cellValue(id.Row, col)
+	}
+	t.w.Clipboard().SetContent(strings.Join(values, "\t"))
+	t.setStatus(fmt.Sprintf("Copied row %d", id.Row+1))
+}
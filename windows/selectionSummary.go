@@ -0,0 +1,56 @@
+package windows
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2/widget"
+)
+
+// selectionSummary describes the selection for the status bar: the row in
+// row mode, or the column and value of the cell in cell mode. Tables select
+// a single cell or row, so the count is always one. When the cell is in a
+// numeric column the sum, average, minimum and maximum of the whole column
+// over the loaded rows are added, labeled as such.
+func selectionSummary(dataItem *Data, id widget.TableCellID) string {
+	if dataItem.selectMode == selectionModeRow {
+		return fmt.Sprintf("1 row selected: row %d of %d, %d columns", id.Row+1, len(dataItem.data), len(dataItem.header))
+	}
+	value := dataItem.cellText(id.Row, id.Col)
+	if dataItem.isFloat(id.Col) {
+		value = dataItem.cellValue(id.Row, id.Col)
+	}
+	summary := fmt.Sprintf("1 cell selected: row %d of %d, %s: %s", id.Row+1, len(dataItem.data), dataItem.header[id.Col], value)
+	values, ok := numericColumn(dataItem, id.Col)
+	if !ok || len(values) == 0 {
+		return summary
+	}
+	sum, min, max := 0.0, values[0], values[0]
+	for _, v := range values {
+		sum += v
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return fmt.Sprintf("%s  |  column %s over %d loaded rows: sum %g  avg %g  min %g  max %g", summary,
+		dataItem.header[id.Col], len(dataItem.data), sum, sum/float64(len(values)), min, max)
+}
+
+// numericColumn parses the non-empty values of column col, reporting false
+// if any of them is not a number.
+func numericColumn(dataItem *Data, col int) ([]float64, bool) {
+	values := make([]float64, 0, len(dataItem.data))
+	for _, row := range dataItem.data {
+		if row[col] == "" {
+			continue
+		}
+		v, err := dataItem.format.parseFloat(row[col])
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, v)
+	}
+	return values, true
+}
@@ -0,0 +1,117 @@
+package windows
+
+import (
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	lastProfilePreference       = "lastProfile"
+	reopenLastProfilePreference = "reopenLastProfile"
+)
+
+// ShowSettings opens the preferences dialog. Changes are stored in the app
+// preferences as soon as they are made.
+func (t *MainWindow) ShowSettings() {
+	prefs := t.a.Preferences()
+
+	reopen := widget.NewCheck("Reopen last profile on startup", func(b bool) {
+		prefs.SetBool(reopenLastProfilePreference, b)
+	})
+	reopen.Checked = prefs.Bool(reopenLastProfilePreference)
+
+	backgroundLoad := widget.NewCheck("Load whole tables in the background", func(b bool) {
+		prefs.SetBool(backgroundLoadPreference, b)
+	})
+	backgroundLoad.Checked = prefs.Bool(backgroundLoadPreference)
+
+	inferTypes := widget.NewCheck("Infer column types of pasted tables", func(b bool) {
+		prefs.SetBool(inferTypesPreference, b)
+	})
+	inferTypes.Checked = prefs.BoolWithFallback(inferTypesPreference, true)
+
+	separator := widget.NewEntry()
+	separator.SetText(prefs.StringWithFallback(pathSeparatorPreference, "."))
+	separator.OnChanged = func(s string) {
+		prefs.SetString(pathSeparatorPreference, s)
+	}
+
+	headerTypes := widget.NewSelect(headerTypeModes, func(mode string) {
+		t.browser().SetHeaderTypes(mode)
+	})
+	headerTypes.Selected = prefs.StringWithFallback(headerTypesPreference, headerTypesOff)
+
+	numbers := widget.NewSelect(numberFormatNames(), func(name string) {
+		prefs.SetString(numberFormatPreference, name)
+	})
+	numbers.Selected = currentNumberFormat().name
+
+	thousands := widget.NewCheck("Group thousands in the grid", func(b bool) {
+		t.browser().SetThousands(b)
+	})
+	thousands.Checked = showThousands()
+
+	delimiter := widget.NewSelect(csvDelimiterNames(), func(name string) {
+		prefs.SetString(csvDelimiterPreference, name)
+	})
+	delimiter.Selected = currentCSVDelimiter()
+
+	decimals := widget.NewSelect(nil, func(s string) {
+		if p, err := strconv.Atoi(s); err == nil {
+			t.browser().SetFloatPrecision(p)
+		}
+	})
+	for p := 0; p <= maxFloatPrecision; p++ {
+		decimals.Options = append(decimals.Options, strconv.Itoa(p))
+	}
+	decimals.Selected = strconv.Itoa(currentFloatPrecision())
+
+	cellLength := widget.NewEntry()
+	cellLength.SetText(strconv.Itoa(maxCellLength()))
+	cellLength.OnChanged = func(s string) {
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+			prefs.SetInt(maxCellLengthPreference, n)
+		}
+	}
+
+	timestamps := widget.NewSelect(timestampDisplayNames(), t.browser().SetTimestampDisplay)
+	timestamps.Selected = prefs.StringWithFallback(timestampDisplayPreference, timestampDisplays[0].name)
+
+	exportPrecision := widget.NewSelect(exportPrecisions, func(s string) {
+		prefs.SetString(exportPrecisionPreference, s)
+	})
+	exportPrecision.Selected = prefs.StringWithFallback(exportPrecisionPreference, exportPrecisionDisplayed)
+
+	selection := widget.NewSelect(selectionModes, func(mode string) {
+		prefs.SetString(selectionModePreference, mode)
+	})
+	selection.Selected = defaultSelectionMode()
+
+	timeZone := widget.NewSelectEntry([]string{"UTC", "Local"})
+	timeZone.SetText(prefs.StringWithFallback(timeZonePreference, "UTC"))
+	timeZone.OnChanged = func(s string) {
+		if _, err := time.LoadLocation(s); err == nil && s != "" {
+			prefs.SetString(timeZonePreference, s)
+		}
+	}
+
+	dialog.NewCustom("Settings", "Close", widget.NewForm(
+		widget.NewFormItem("Startup", reopen),
+		widget.NewFormItem("Column types", headerTypes),
+		&widget.FormItem{Text: "Tables", Widget: backgroundLoad, HintText: "The first rows are shown while the rest loads"},
+		widget.NewFormItem("Import", inferTypes),
+		widget.NewFormItem("Numbers", numbers),
+		widget.NewFormItem("", thousands),
+		&widget.FormItem{Text: "CSV delimiter", Widget: delimiter, HintText: "Of exported CSV files, pasted tables are still detected"},
+		&widget.FormItem{Text: "Decimals", Widget: decimals, HintText: "Decimals of float columns, full values are kept"},
+		widget.NewFormItem("Timestamps", timestamps),
+		&widget.FormItem{Text: "Max cell length", Widget: cellLength, HintText: "Longer values are cut off, click them to see all, 0 for no limit"},
+		&widget.FormItem{Text: "Export precision", Widget: exportPrecision, HintText: "Full writes floats, decimals and timestamps unrounded"},
+		&widget.FormItem{Text: "Selection", Widget: selection, HintText: "Default selection mode of new tables"},
+		&widget.FormItem{Text: "Time zone", Widget: timeZone, HintText: "UTC, Local or a name such as Europe/Stockholm"},
+		widget.NewFormItem("Path separator", separator),
+	), t.w).Show()
+}
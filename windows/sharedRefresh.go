@@ -0,0 +1,83 @@
+package windows
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+	delta_sharing "github.com/magpierre/go_delta_sharing_client"
+)
+
+// defaultRefreshInterval is how often a shared table is refreshed until
+// another interval is chosen for its tab.
+const defaultRefreshInterval = time.Minute
+
+var refreshIntervals = []string{"30s", "1m", "5m", "15m", "1h"}
+
+// sharedTableWatch refreshes a Delta Sharing table by reading its files
+// again, as the server gives no way to tell whether it changed.
+func (t *DataBrowser) sharedTableWatch(profile string, table delta_sharing.Table) *fileWatch {
+	return &fileWatch{
+		path:     qualifiedName(table.Share, table.Schema, table.Name),
+		interval: defaultRefreshInterval,
+		reload: func() (Data, error) {
+			return t.readSharedTable(profile, table)
+		},
+	}
+}
+
+// readSharedTable reads the first file of a Delta Sharing table; its other
+// files are read as the user scrolls down.
+func (t *DataBrowser) readSharedTable(profile string, table delta_sharing.Table) (Data, error) {
+	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), profile, "")
+	if err != nil {
+		return Data{}, err
+	}
+	resp, err := ds.ListFilesInTable(table)
+	if err != nil {
+		return Data{}, err
+	}
+	if len(resp.AddFiles) == 0 {
+		return Data{}, fmt.Errorf("%s has no files", qualifiedName(table.Share, table.Schema, table.Name))
+	}
+	ids := make([]string, len(resp.AddFiles))
+	for i, f := range resp.AddFiles {
+		ids[i] = f.Id
+	}
+	load := func(fileID string) (arrow.Table, error) {
+		return delta_sharing.LoadArrowTable(ds, table, fileID)
+	}
+	first, err := load(ids[0])
+	if err != nil {
+		return Data{}, err
+	}
+	data := t.newArrowData(first, qualifiedName(table.Share, table.Schema, table.Name), ids[1:], load)
+	data.addFiles = resp.AddFiles
+	return data, nil
+}
+
+// newRefreshIntervalSelect chooses how often the tab of a shared table is
+// refreshed while auto-refresh is on.
+func (t *DataBrowser) newRefreshIntervalSelect(table *widget.Table, dataItem *Data) *widget.Select {
+	s := widget.NewSelect(refreshIntervals, func(s string) {
+		d, err := time.ParseDuration(s)
+		if err != nil || d == dataItem.watch.interval {
+			return
+		}
+		running := dataItem.watch.stop != nil
+		dataItem.watch.close()
+		dataItem.watch.interval = d
+		if running {
+			t.startWatch(table, dataItem)
+		}
+	})
+	s.Selected = dataItem.watch.interval.String()
+	for _, option := range refreshIntervals {
+		if d, _ := time.ParseDuration(option); d == dataItem.watch.interval {
+			s.Selected = option
+		}
+	}
+	return s
+}
@@ -0,0 +1,101 @@
+package windows
+
+import (
+	"slices"
+
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// SelectNextTab moves step tabs forward in the inner data tabs, wrapping
+// around at either end.
+func (t *DataBrowser) SelectNextTab(step int) {
+	if t.innerTabs == nil || len(t.innerTabs.Items) == 0 {
+		return
+	}
+	n := len(t.innerTabs.Items)
+	t.innerTabs.SelectIndex(((t.innerTabs.SelectedIndex()+step)%n + n) % n)
+}
+
+// MoveCurrentTab moves the selected data tab step places, stopping at
+// either end. The tab buttons of DocTabs take neither drags nor double taps,
+// so tabs are moved and renamed with commands instead.
+func (t *DataBrowser) MoveCurrentTab(step int) {
+	if t.innerTabs == nil || t.innerTabs.Selected() == nil {
+		return
+	}
+	i := t.innerTabs.SelectedIndex()
+	j := max(0, min(i+step, len(t.tabs)-1))
+	if i == j {
+		return
+	}
+	ti := t.tabs[i]
+	t.tabs = slices.Insert(slices.Delete(slices.Clone(t.tabs), i, i+1), j, ti)
+	t.innerTabs.SetItems(slices.Clone(t.tabs))
+	t.innerTabs.Select(ti)
+}
+
+// RenameCurrentTab changes the title of the selected data tab. The name the
+// tab was opened with is kept on its table, so an empty title restores it.
+func (t *DataBrowser) RenameCurrentTab() {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
+	}
+	ti := t.innerTabs.Selected()
+	title := widget.NewEntry()
+	title.SetText(ti.Text)
+	dialog.NewForm("Rename Tab", "Rename", "Cancel", []*widget.FormItem{
+		{Text: "Title", Widget: title, HintText: "Opened as " + dataItem.name + ", leave empty to restore"},
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		ti.Text = title.Text
+		if ti.Text == "" {
+			ti.Text = dataItem.name
+		}
+		t.innerTabs.Refresh()
+	}, t.w).Show()
+}
+
+// CloseCurrentTab closes the selected data tab.
+func (t *DataBrowser) CloseCurrentTab() {
+	if t.innerTabs == nil || t.innerTabs.Selected() == nil {
+		return
+	}
+	t.closeTab(t.innerTabs.Selected())
+}
+
+// closeTab removes a data tab and releases the Arrow memory of its table.
+func (t *DataBrowser) closeTab(ti *container.TabItem) {
+	table := t.tabTables[ti]
+	if dataItem := t.tables[table]; dataItem != nil {
+		// Under the lock, so a reload in flight cannot swap in a new pager
+		// after this one is closed.
+		t.mu.Lock()
+		if dataItem.pager != nil {
+			dataItem.pager.close()
+		}
+		if dataItem.watch != nil {
+			dataItem.watch.close()
+		}
+		t.mu.Unlock()
+	}
+	// A new slice, as removing the tab edits the items of the tabs widget
+	// in place.
+	if i := slices.Index(t.tabs, ti); i >= 0 {
+		t.tabs = slices.Delete(slices.Clone(t.tabs), i, i+1)
+	}
+	delete(t.tabTables, ti)
+	delete(t.tables, table)
+	t.mu.Lock()
+	delete(t.selection, table)
+	delete(t.zoom, table)
+	t.mu.Unlock()
+	delete(t.findBars, table)
+	delete(t.schemaTrees, table)
+	delete(t.keyChecks, table)
+	t.innerTabs.Remove(ti)
+}
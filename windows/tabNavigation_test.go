@@ -0,0 +1,74 @@
+package windows
+
+import (
+	"slices"
+	"testing"
+
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+// newTestTabs returns a browser with data tabs titled by titles, built as
+// CreateDataBrowser builds them.
+func newTestTabs(titles ...string) *DataBrowser {
+	t := &DataBrowser{}
+	for _, title := range titles {
+		t.tabs = append(t.tabs, container.NewTabItem(title, widget.NewLabel(title)))
+	}
+	t.innerTabs = container.NewDocTabs(t.tabs...)
+	return t
+}
+
+func tabTitles(items []*container.TabItem) []string {
+	titles := make([]string, len(items))
+	for i, ti := range items {
+		titles[i] = ti.Text
+	}
+	return titles
+}
+
+func TestCloseMiddleTab(t *testing.T) {
+	test.NewApp()
+	b := newTestTabs("A", "B", "C")
+	b.closeTab(b.tabs[1])
+	for _, items := range [][]*container.TabItem{b.tabs, b.innerTabs.Items} {
+		if got := tabTitles(items); !slices.Equal(got, []string{"A", "C"}) {
+			t.Errorf("tabs after closing B are %v, want [A C]", got)
+		}
+	}
+}
+
+func TestMoveCurrentTab(t *testing.T) {
+	test.NewApp()
+	tests := []struct {
+		selected int
+		step     int
+		want     []string
+	}{
+		{0, 1, []string{"B", "A", "C"}},
+		{1, -1, []string{"B", "A", "C"}},
+		{0, 5, []string{"B", "C", "A"}},
+		{2, -5, []string{"C", "A", "B"}},
+		{2, 1, []string{"A", "B", "C"}},
+	}
+	for _, tt := range tests {
+		b := newTestTabs("A", "B", "C")
+		b.innerTabs.SelectIndex(tt.selected)
+		moved := b.innerTabs.Selected()
+		b.MoveCurrentTab(tt.step)
+		for _, items := range [][]*container.TabItem{b.tabs, b.innerTabs.Items} {
+			if got := tabTitles(items); !slices.Equal(got, tt.want) {
+				t.Errorf("moving tab %d by %d gives %v, want %v", tt.selected, tt.step, got, tt.want)
+			}
+		}
+		if b.innerTabs.Selected() != moved {
+			t.Errorf("moving tab %d by %d selects %q, want %q", tt.selected, tt.step, b.innerTabs.Selected().Text, moved.Text)
+		}
+		// Closing a tab after the move must leave both lists in step.
+		b.closeTab(b.tabs[1])
+		if got, items := tabTitles(b.tabs), tabTitles(b.innerTabs.Items); !slices.Equal(got, items) {
+			t.Errorf("after moving tab %d by %d and closing the middle tab: %v, tabs widget has %v", tt.selected, tt.step, got, items)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package windows
+
+import (
+	"encoding/json"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// tableNotesPreference holds the notes of all tables as a JSON object keyed
+// by share.schema.table. Notes are local to this machine and are never sent
+// to the server.
+const tableNotesPreference = "tableNotes"
+
+func (t *MainWindow) tableNotes() map[string]string {
+	notes := make(map[string]string)
+	json.Unmarshal([]byte(t.a.Preferences().String(tableNotesPreference)), &notes)
+	return notes
+}
+
+// tableNote returns the note of a table of the selected schema.
+func (t *MainWindow) tableNote(name string) string {
+	return t.tableNotes()[qualifiedName(t.selected.share, t.selected.schema, name)]
+}
+
+// tableLabel is the text of a table in the navigation: its name followed by
+// its note, if it has one.
+func (t *MainWindow) tableLabel(name string) string {
+	if note := t.tableNote(name); note != "" {
+		return name + "  [" + note + "]"
+	}
+	return name
+}
+
+// tableLabelBinding shows a bound table name through tableLabel, so the
+// navigation list keeps following the binding while showing notes.
+type tableLabelBinding struct {
+	binding.String
+	label func(string) string
+}
+
+func (b tableLabelBinding) Get() (string, error) {
+	name, err := b.String.Get()
+	return b.label(name), err
+}
+
+// EditTableNotes lets the user set the note of a table, such as "PII" or
+// "deprecated". An empty note removes it.
+func (t *MainWindow) EditTableNotes(share, schema, name string) {
+	key := qualifiedName(share, schema, name)
+	note := widget.NewEntry()
+	note.SetText(t.tableNotes()[key])
+	note.SetPlaceHolder("PII, deprecated")
+	dialog.NewForm("Notes for "+name, "Save", "Cancel", []*widget.FormItem{
+		{Text: "Notes", Widget: note, HintText: "Shown next to the table and searchable in the command palette"},
+	}, func(ok bool) {
+		if !ok {
+			return
+		}
+		notes := t.tableNotes()
+		if note.Text == "" {
+			delete(notes, key)
+		} else {
+			notes[key] = note.Text
+		}
+		b, _ := json.Marshal(notes)
+		t.a.Preferences().SetString(tableNotesPreference, string(b))
+		t.tablesWidget.Refresh()
+	}, t.w).Show()
+}
+
+// tableNotesMenuItem opens EditTableNotes for the table at parts.
+func (t *MainWindow) tableNotesMenuItem(parts []string) *fyne.MenuItem {
+	return fyne.NewMenuItem("Edit Notes...", func() {
+		t.EditTableNotes(parts[0], parts[1], parts[2])
+	})
+}
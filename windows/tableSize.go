@@ -0,0 +1,96 @@
+package windows
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2/driver/desktop"
+	delta_sharing "github.com/magpierre/go_delta_sharing_client"
+)
+
+// sizeUnknown is shown for tables whose server reports no row counts or
+// file sizes.
+const sizeUnknown = "size unknown"
+
+var _ desktop.Hoverable = (*menuLabel)(nil)
+
+func (l *menuLabel) MouseIn(*desktop.MouseEvent) {
+	if l.hover != nil {
+		l.hover(true)
+	}
+}
+
+// MouseMoved shows the size again, so a size fetched while the pointer
+// rests on a table replaces the fetching message.
+func (l *menuLabel) MouseMoved(*desktop.MouseEvent) {
+	if l.hover != nil {
+		l.hover(false)
+	}
+}
+
+func (l *menuLabel) MouseOut() {}
+
+// tableSizeEntry is the cached size of a table, or the error fetching it.
+type tableSizeEntry struct {
+	text string
+	err  error
+}
+
+// fetchingSize is cached while the size of a table is being fetched.
+var fetchingSize = &tableSizeEntry{text: "fetching size..."}
+
+// tableSize describes the size of a table before it is loaded: its
+// approximate row count from the statistics of its files, and its files
+// and bytes from the table metadata. Servers that report neither give
+// sizeUnknown.
+func (t *MainWindow) tableSize(table delta_sharing.Table) (string, error) {
+	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), t.profile, "")
+	if err != nil {
+		return "", err
+	}
+	files, err := ds.ListFilesInTable(table)
+	if err != nil {
+		return "", err
+	}
+	var parts []string
+	data := Data{addFiles: files.AddFiles}
+	if rows := data.totalRows(); rows >= 0 {
+		parts = append(parts, fmt.Sprintf("~%d rows", rows))
+	}
+	if m, err := ds.GetTableMetadata(table); err == nil && m.NumFiles > 0 {
+		parts = append(parts, fmt.Sprintf("%d files, %d bytes", m.NumFiles, m.Size))
+	}
+	if len(parts) == 0 {
+		return sizeUnknown, nil
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+// showTableSize shows the size of a table in the status bar. Sizes are
+// fetched in the background the first time a table is hovered and cached
+// until the profile is reloaded; a failed fetch is retried when the table
+// is hovered again. The status bar only shows sizes from the hover
+// callbacks, so it is never set from the fetching goroutine.
+func (t *MainWindow) showTableSize(share, schema, name string, retry bool) {
+	key := qualifiedName(share, schema, name)
+	cached, loaded := t.tableSizes.LoadOrStore(key, fetchingSize)
+	entry := cached.(*tableSizeEntry)
+	if loaded && retry && entry.err != nil && t.tableSizes.CompareAndSwap(key, entry, fetchingSize) {
+		entry, loaded = fetchingSize, false
+	}
+	text := key + ": " + entry.text
+	if entry.err != nil {
+		text = fmt.Sprintf("%s: size unavailable: %v", key, entry.err)
+	}
+	if t.status.Text != text {
+		t.status.SetText(text)
+	}
+	if loaded {
+		return
+	}
+	go func() {
+		size, err := t.tableSize(delta_sharing.Table{Share: share, Schema: schema, Name: name})
+		t.tableSizes.Store(key, &tableSizeEntry{text: size, err: err})
+	}()
+}
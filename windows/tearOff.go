@@ -0,0 +1,26 @@
+package windows
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// OpenInNewWindow shows the current table in a window of its own. The new
+// window shares the loaded rows and Arrow data with the tab; closing it only
+// drops its table widget, the data is released with the tab.
+func (t *DataBrowser) OpenInNewWindow() {
+	_, dataItem := t.currentTable()
+	if dataItem == nil {
+		return
+	}
+	table := t.newDataTable(dataItem)
+	w := fyne.CurrentApp().NewWindow(t.innerTabs.Selected().Text)
+	w.SetContent(table)
+	w.Resize(fyne.NewSize(800, 600))
+	w.SetOnClosed(func() {
+		delete(t.tables, table)
+		t.mu.Lock()
+		delete(t.selection, table)
+		t.mu.Unlock()
+	})
+	w.Show()
+}
@@ -0,0 +1,49 @@
+package windows
+
+import "fyne.io/fyne/v2"
+
+const thousandsPreference = "thousandsSeparators"
+
+// showThousands reports whether numbers are shown with thousands separators
+// in the grid. Exports and sorting keep the ungrouped values.
+func showThousands() bool {
+	return fyne.CurrentApp().Preferences().Bool(thousandsPreference)
+}
+
+// withThousands returns f grouping thousands, with a comma or, where the
+// comma is the decimal separator, a dot.
+func (f numberFormat) withThousands() numberFormat {
+	if f.group != "" {
+		return f
+	}
+	f.group = ","
+	if f.decimal == "," {
+		f.group = "."
+	}
+	if f.decimal == "" {
+		f.decimal = "."
+	}
+	return f
+}
+
+// gridValue is displayValue as shown in the grid, where integer, float and
+// decimal values are grouped in thousands if that is chosen in the settings.
+func (d *Data) gridValue(row, col int) string {
+	v := d.displayValue(row, col)
+	if !showThousands() || col >= len(d.fields) || d.isNull(row, col) {
+		return v
+	}
+	switch typeCategory(d.fields[col].Type) {
+	case "integer", "float", "decimal":
+		return d.format.withThousands().localize(d.format.delocalize(v))
+	}
+	return v
+}
+
+// SetThousands switches thousands separators in all open tables.
+func (t *DataBrowser) SetThousands(on bool) {
+	fyne.CurrentApp().Preferences().SetBool(thousandsPreference, on)
+	for table := range t.tables {
+		table.Refresh()
+	}
+}
@@ -0,0 +1,30 @@
+package windows
+
+import "testing"
+
+func TestWithThousands(t *testing.T) {
+	us, european := numberFormats[0], numberFormats[1]
+	tests := []struct {
+		name   string
+		format numberFormat
+		plain  string
+		want   string
+	}{
+		{"zero value", numberFormat{}, "-1234567.5", "-1,234,567.5"},
+		{"US", us, "1234567.25", "1,234,567.25"},
+		{"US short", us, "999", "999"},
+		{"European", european, "1234567.25", "1.234.567,25"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := tt.format.withThousands()
+			got := f.localize(tt.plain)
+			if got != tt.want {
+				t.Errorf("localize(%q) = %q, want %q", tt.plain, got, tt.want)
+			}
+			if back := f.delocalize(got); back != tt.plain {
+				t.Errorf("delocalize(%q) = %q, want %q", got, back, tt.plain)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package windows
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+const (
+	timeZonePreference         = "timeZone"
+	exportUTCPreference        = "exportUTC"
+	timestampDisplayPreference = "timestampDisplay"
+)
+
+// timestampLayout is the layout timestamps are stored in, the default
+// format of time.Time.
+const timestampLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+// timestampDisplays are the resolutions timestamps can be shown in. The
+// offset is kept so shortened timestamps can still be converted to UTC.
+var timestampDisplays = []struct{ name, layout string }{
+	{"Full", timestampLayout},
+	{"Seconds", "2006-01-02 15:04:05 -0700 MST"},
+	{"Minutes", "2006-01-02 15:04 -0700 MST"},
+}
+
+func timestampDisplayNames() []string {
+	names := make([]string, len(timestampDisplays))
+	for i, d := range timestampDisplays {
+		names[i] = d.name
+	}
+	return names
+}
+
+// timestampDisplayLayout returns the layout of the resolution chosen in the
+// settings.
+func timestampDisplayLayout() string {
+	name := fyne.CurrentApp().Preferences().String(timestampDisplayPreference)
+	for _, d := range timestampDisplays {
+		if d.name == name {
+			return d.layout
+		}
+	}
+	return timestampLayout
+}
+
+// SetTimestampDisplay changes the resolution timestamps are shown in, in
+// all open tables.
+func (t *DataBrowser) SetTimestampDisplay(name string) {
+	fyne.CurrentApp().Preferences().SetString(timestampDisplayPreference, name)
+	for table := range t.tables {
+		table.Refresh()
+	}
+}
+
+// isTimestamp reports whether col holds Arrow timestamps.
+func (d *Data) isTimestamp(col int) bool {
+	return col < len(d.fields) && d.fields[col].Type.ID() == arrow.TIMESTAMP
+}
+
+// displayTimestamp shortens a stored timestamp to the chosen resolution.
+func displayTimestamp(v string) string {
+	layout := timestampDisplayLayout()
+	if layout == timestampLayout {
+		return v
+	}
+	t, err := time.Parse(timestampLayout, v)
+	if err != nil {
+		return v
+	}
+	return t.Format(layout)
+}
+
+// currentLocation returns the time zone chosen in the settings: UTC, Local
+// or an IANA name such as Europe/Stockholm.
+func currentLocation() *time.Location {
+	name := fyne.CurrentApp().Preferences().StringWithFallback(timeZonePreference, "UTC")
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// formatTimestamp renders v of a timestamp column. Timestamps with a time
+// zone are instants and are shown in loc; those without one are wall clock
+// times and are shown as stored.
+func formatTimestamp(v arrow.Timestamp, dt *arrow.TimestampType, loc *time.Location) string {
+	t := v.ToTime(dt.Unit)
+	if dt.TimeZone != "" && loc != nil {
+		t = t.In(loc)
+	}
+	return t.Format(timestampLayout)
+}
+
+// timestampsToUTC rewrites the timestamp columns of data in UTC, keeping
+// the resolution they were written in.
+func timestampsToUTC(data Data) Data {
+	var cols []int
+	for i, f := range data.fields {
+		if dt, ok := f.Type.(*arrow.TimestampType); ok && dt.TimeZone != "" {
+			cols = append(cols, i)
+		}
+	}
+	if len(cols) == 0 {
+		return data
+	}
+	rows := make([][]string, len(data.data))
+	for r, row := range data.data {
+		rows[r] = append([]string(nil), row...)
+		for _, c := range cols {
+			for _, layout := range []string{timestampLayout, timestampDisplayLayout()} {
+				if t, err := time.Parse(layout, row[c]); err == nil {
+					rows[r][c] = t.UTC().Format(layout)
+					break
+				}
+			}
+		}
+	}
+	data.data = rows
+	return data
+}
@@ -0,0 +1,131 @@
+package windows
+
+import (
+	"fmt"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+const showTotalsPreference = "showTotals"
+
+var aggregateFuncs = []string{"sum", "avg", "min", "max", "count"}
+
+// SetShowTotals toggles a header line with an aggregate of each column:
+// by default the sum of numeric columns and the count of non-null values of
+// the others. The table widget cannot pin a footer row, so the totals sit
+// with the other header lines, which stay visible while scrolling.
+func (t *DataBrowser) SetShowTotals(show bool) {
+	t.showTotals = show
+	fyne.CurrentApp().Preferences().SetBool(showTotalsPreference, show)
+	for table := range t.tables {
+		t.updateHeaderHeight(table)
+		table.Refresh()
+	}
+}
+
+// aggregateFunc is the aggregate shown for col.
+func (d *Data) aggregateFunc(col int) string {
+	if fn, ok := d.aggregates[col]; ok {
+		return fn
+	}
+	if _, ok := numericColumn(d, col); ok {
+		return "sum"
+	}
+	return "count"
+}
+
+// columnTotals computes the aggregate of every loaded column on first use
+// and caches it on the table.
+func (d *Data) columnTotals() []string {
+	if d.totals != nil {
+		return d.totals
+	}
+	d.totals = make([]string, len(d.header))
+	for col := range d.header {
+		d.totals[col] = d.aggregate(col, d.aggregateFunc(col))
+	}
+	return d.totals
+}
+
+func (d *Data) aggregate(col int, fn string) string {
+	if fn == "count" {
+		n := 0
+		for row := range d.data {
+			if !d.isNull(row, col) {
+				n++
+			}
+		}
+		return fmt.Sprintf("count %d", n)
+	}
+	values, ok := numericColumn(d, col)
+	if !ok || len(values) == 0 {
+		return fn + " -"
+	}
+	return fn + " " + strconv.FormatFloat(aggregateValues(values, fn), 'g', -1, 64)
+}
+
+// aggregateValues applies one of the aggregateFuncs other than count to
+// values, which must not be empty.
+func aggregateValues(values []float64, fn string) float64 {
+	result := values[0]
+	switch fn {
+	case "sum", "avg":
+		result = 0
+		for _, v := range values {
+			result += v
+		}
+		if fn == "avg" {
+			result /= float64(len(values))
+		}
+	case "min":
+		for _, v := range values {
+			result = min(result, v)
+		}
+	case "max":
+		for _, v := range values {
+			result = max(result, v)
+		}
+	}
+	return result
+}
+
+// ChooseTotals lets the user pick the aggregate of each column of the
+// current table.
+func (t *DataBrowser) ChooseTotals() {
+	table, dataItem := t.currentTable()
+	if table == nil {
+		return
+	}
+	t.mu.Lock()
+	selects := make([]*widget.Select, len(dataItem.header))
+	items := make([]*widget.FormItem, len(dataItem.header))
+	for col, name := range dataItem.header {
+		selects[col] = widget.NewSelect(aggregateFuncs, nil)
+		selects[col].Selected = dataItem.aggregateFunc(col)
+		items[col] = widget.NewFormItem(name, selects[col])
+	}
+	t.mu.Unlock()
+	form := widget.NewForm(items...)
+	d := dialog.NewCustomConfirm("Column Totals", "Apply", "Cancel", container.NewVScroll(form), func(ok bool) {
+		if !ok {
+			return
+		}
+		t.mu.Lock()
+		dataItem.aggregates = make(map[int]string, len(selects))
+		for col, s := range selects {
+			dataItem.aggregates[col] = s.Selected
+		}
+		dataItem.totals = nil
+		t.mu.Unlock()
+		if !t.showTotals {
+			t.SetShowTotals(true)
+		}
+		table.Refresh()
+	}, t.w)
+	d.Resize(fyne.NewSize(350, 400))
+	d.Show()
+}
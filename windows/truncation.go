@@ -0,0 +1,151 @@
+package windows
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// backgroundLoadPreference makes opened tables read all their rows in the
+// background after showing the first batch.
+const backgroundLoadPreference = "loadInBackground"
+
+// truncationBanner tells the user that a table only shows the rows read so
+// far and offers to read the rest.
+type truncationBanner struct {
+	box   *fyne.Container
+	label *widget.Label
+}
+
+func (t *DataBrowser) newTruncationBanner(table *widget.Table, dataItem *Data) *truncationBanner {
+	b := &truncationBanner{label: widget.NewLabel("")}
+	b.label.TextStyle = fyne.TextStyle{Bold: true}
+	b.box = container.NewHBox(b.label, widget.NewButton("Load all", func() {
+		t.loadAll(table, dataItem)
+	}))
+	return b
+}
+
+// totalRows is the row count of the whole table according to the file
+// statistics of a Delta Sharing table, or -1 when it is not known.
+func (d *Data) totalRows() int64 {
+	if d.addFiles == nil {
+		return -1
+	}
+	var total int64
+	for _, f := range d.addFiles {
+		stats, err := f.GetStats()
+		if err != nil {
+			return -1
+		}
+		total += stats.NumRecords
+	}
+	return total
+}
+
+// stopLoadsExcept stops the background loads of the tables of all tabs but
+// keep, which may be nil to stop them all.
+func (t *DataBrowser) stopLoadsExcept(keep *container.TabItem) {
+	for ti, table := range t.tabTables {
+		if ti == keep {
+			continue
+		}
+		if dataItem := t.tables[table]; dataItem != nil {
+			if p := t.pagerOf(dataItem); p != nil {
+				p.cancelLoad()
+			}
+		}
+	}
+}
+
+// updateTruncation shows the banner of dataItem while more rows can be read.
+func (t *DataBrowser) updateTruncation(dataItem *Data) {
+	t.mu.Lock()
+	p := dataItem.pager
+	if p == nil || p.banner == nil {
+		t.mu.Unlock()
+		return
+	}
+	loaded := int64(len(dataItem.data))
+	total := dataItem.totalRows()
+	t.mu.Unlock()
+	if p.finished() || (total >= 0 && loaded >= total) {
+		p.banner.box.Hide()
+		return
+	}
+	if total >= 0 {
+		p.banner.label.SetText(fmt.Sprintf("Showing first %d of %d rows", loaded, total))
+	} else {
+		p.banner.label.SetText(fmt.Sprintf("Showing first %d rows, more are available", loaded))
+	}
+	p.banner.box.Show()
+}
+
+// loadAll reads all remaining rows of dataItem in the background, showing
+// each batch as it arrives and the progress in the status bar. The pager is
+// only locked while it hands out a batch, so closing the tab stops the load
+// after the batch being read. Switching to another tab or away from the
+// browser stops it the same way; the banner then offers to load the rest.
+func (t *DataBrowser) loadAll(table *widget.Table, dataItem *Data) {
+	p := t.pagerOf(dataItem)
+	if p == nil || !p.begin() {
+		return
+	}
+	if p.indicator != nil {
+		p.indicator.Show()
+	}
+	go func() {
+		defer func() {
+			p.end()
+			if p.indicator != nil {
+				p.indicator.Hide()
+			}
+			t.updateRowHeights(table, dataItem)
+			t.updateTruncation(dataItem)
+			table.Refresh()
+		}()
+		t.mu.Lock()
+		total := dataItem.totalRows()
+		t.mu.Unlock()
+		for {
+			if p.loadCancelled() {
+				t.setStatus(fmt.Sprintf("Stopped loading %s", dataItem.source))
+				return
+			}
+			rec, err := p.next()
+			if err != nil {
+				t.showError(err)
+				return
+			}
+			if rec == nil {
+				t.mu.Lock()
+				loaded := len(dataItem.data)
+				t.mu.Unlock()
+				t.setStatus(fmt.Sprintf("Loaded all %d rows of %s", loaded, dataItem.source))
+				return
+			}
+			t.mu.Lock()
+			if dataItem.pager != p {
+				t.mu.Unlock()
+				rec.Release()
+				return
+			}
+			evolution := dataItem.appendRecord(rec)
+			loaded := len(dataItem.data)
+			t.mu.Unlock()
+			rec.Release()
+			switch {
+			case evolution != "":
+				t.setStatus(evolution)
+			case total >= 0:
+				t.setStatus(fmt.Sprintf("Loaded %d / %d rows of %s", loaded, total, dataItem.source))
+			default:
+				t.setStatus(fmt.Sprintf("Loaded %d rows of %s", loaded, dataItem.source))
+			}
+			t.updateRowHeights(table, dataItem)
+			table.Refresh()
+		}
+	}()
+}
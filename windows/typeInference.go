@@ -0,0 +1,82 @@
+package windows
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+const (
+	inferTypesPreference = "inferTypes"
+	// inferSampleRows is how many rows are looked at to guess a type.
+	inferSampleRows = 1000
+)
+
+// dateLayouts are the date and timestamp formats recognised in text data.
+var dateLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"}
+
+// inferFields guesses an Arrow type for every column of text data from its
+// first rows. Empty cells are ignored; a column whose values do not all
+// parse as the same type stays a string.
+func inferFields(header []string, rows [][]string) []arrow.Field {
+	if len(rows) > inferSampleRows {
+		rows = rows[:inferSampleRows]
+	}
+	fields := make([]arrow.Field, len(header))
+	for col, name := range header {
+		fields[col] = arrow.Field{Name: name, Type: inferType(rows, col), Nullable: true}
+	}
+	return fields
+}
+
+func inferType(rows [][]string, col int) arrow.DataType {
+	isInt, isFloat, isBool, isDate, isTimestamp := true, true, true, true, true
+	seen := false
+	for _, row := range rows {
+		v := row[col]
+		if v == "" {
+			continue
+		}
+		seen = true
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			isInt = false
+		}
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			isFloat = false
+		}
+		if _, err := strconv.ParseBool(v); err != nil {
+			isBool = false
+		}
+		if _, err := time.Parse("2006-01-02", v); err != nil {
+			isDate = false
+		}
+		if !parsesAsTime(v) {
+			isTimestamp = false
+		}
+	}
+	switch {
+	case !seen:
+		return arrow.BinaryTypes.String
+	case isInt:
+		return arrow.PrimitiveTypes.Int64
+	case isFloat:
+		return arrow.PrimitiveTypes.Float64
+	case isBool:
+		return arrow.FixedWidthTypes.Boolean
+	case isDate:
+		return arrow.FixedWidthTypes.Date32
+	case isTimestamp:
+		return arrow.FixedWidthTypes.Timestamp_us
+	}
+	return arrow.BinaryTypes.String
+}
+
+func parsesAsTime(v string) bool {
+	for _, layout := range dateLayouts {
+		if _, err := time.Parse(layout, v); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package windows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"github.com/apache/arrow-go/v18/arrow"
+	delta_sharing "github.com/magpierre/go_delta_sharing_client"
+)
+
+// ValidateAgainstSelectedTable compares the columns of the current browser
+// tab with the schema of the table selected in the navigation and shows the
+// differences.
+func (t *MainWindow) ValidateAgainstSelectedTable() {
+	b := t.browser()
+	_, dataItem := b.currentTable()
+	if dataItem == nil {
+		t.showError(errors.New("open a table to validate first"))
+		return
+	}
+	b.mu.Lock()
+	fields := dataItem.fields
+	b.mu.Unlock()
+	if fields == nil {
+		t.showError(errors.New("the current tab has no column types to validate"))
+		return
+	}
+	if t.selected.table.Name == "" {
+		t.showError(errors.New("select a Delta Sharing table to validate against"))
+		return
+	}
+	ds, err := delta_sharing.NewSharingClientFromString(context.Background(), t.profile, "")
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	metadata, err := ds.GetTableMetadata(t.selected.table)
+	if err != nil {
+		t.showError(err)
+		return
+	}
+	schema, err := metadata.GetSparkSchema()
+	if err != nil {
+		t.showError(err)
+		return
+	}
+
+	var diff []string
+	got := make(map[string]arrow.Field, len(fields))
+	for _, f := range fields {
+		got[f.Name] = f
+	}
+	for _, f := range schema.Fields {
+		g, ok := got[f.Name]
+		if !ok {
+			diff = append(diff, "missing column "+f.Name)
+			continue
+		}
+		delete(got, f.Name)
+		if want, have := sparkTypeString(f.Type), sparkTypeName(g.Type); want != have {
+			diff = append(diff, fmt.Sprintf("column %s is %s, expected %s", f.Name, have, want))
+		}
+	}
+	for _, f := range fields {
+		if _, ok := got[f.Name]; ok {
+			diff = append(diff, "extra column "+f.Name)
+		}
+	}
+
+	name := qualifiedName(t.selected.table.Share, t.selected.table.Schema, t.selected.table.Name)
+	text := "The columns match " + name + "."
+	if len(diff) > 0 {
+		text = "Differences to " + name + ":\n\n" + strings.Join(diff, "\n")
+	}
+	label := widget.NewLabel(text)
+	label.Wrapping = fyne.TextWrapWord
+	d := dialog.NewCustom("Schema Validation", "Close", container.NewVScroll(label), t.w)
+	d.Resize(fyne.NewSize(500, 400))
+	d.Show()
+}
+
+// sparkTypeString returns the type name of a field in a Spark schema. Nested
+// types are objects whose "type" is struct, array or map.
+func sparkTypeString(t interface{}) string {
+	switch v := t.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		if name, ok := v["type"].(string); ok {
+			return name
+		}
+	}
+	return fmt.Sprintf("%v", t)
+}
+
+// sparkTypeName returns the Spark type name matching an Arrow type.
+func sparkTypeName(t arrow.DataType) string {
+	switch t.ID() {
+	case arrow.INT8:
+		return "byte"
+	case arrow.INT16:
+		return "short"
+	case arrow.INT32:
+		return "integer"
+	case arrow.INT64:
+		return "long"
+	case arrow.FLOAT32:
+		return "float"
+	case arrow.FLOAT64:
+		return "double"
+	case arrow.BOOL:
+		return "boolean"
+	case arrow.STRING, arrow.LARGE_STRING:
+		return "string"
+	case arrow.BINARY, arrow.LARGE_BINARY:
+		return "binary"
+	case arrow.DATE32, arrow.DATE64:
+		return "date"
+	case arrow.TIMESTAMP:
+		if t.(*arrow.TimestampType).TimeZone == "" {
+			return "timestamp_ntz"
+		}
+		return "timestamp"
+	case arrow.DECIMAL128, arrow.DECIMAL256:
+		d := t.(arrow.DecimalType)
+		return fmt.Sprintf("decimal(%d,%d)", d.GetPrecision(), d.GetScale())
+	case arrow.STRUCT:
+		return "struct"
+	case arrow.LIST, arrow.LARGE_LIST:
+		return "array"
+	case arrow.MAP:
+		return "map"
+	}
+	return t.String()
+}
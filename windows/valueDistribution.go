@@ -0,0 +1,82 @@
+package windows
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	// distributionLimit is the number of most frequent values listed in a
+	// value distribution, the remaining ones are counted as other.
+	distributionLimit = 20
+	distributionNull  = "(null)"
+	distributionOther = "(other)"
+)
+
+// valueCount is how often a value occurs in a column.
+type valueCount struct {
+	value string
+	count int
+}
+
+// valueDistribution counts the loaded values of col, most frequent first,
+// keeping the limit most frequent values and adding the rest up as other.
+func valueDistribution(d *Data, col, limit int) []valueCount {
+	counts := make(map[string]int)
+	for row := range d.data {
+		v := d.displayValue(row, col)
+		if d.isNull(row, col) {
+			v = distributionNull
+		}
+		counts[v]++
+	}
+	values := make([]valueCount, 0, len(counts))
+	for v, n := range counts {
+		values = append(values, valueCount{v, n})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].count != values[j].count {
+			return values[i].count > values[j].count
+		}
+		return values[i].value < values[j].value
+	})
+	if len(values) <= limit {
+		return values
+	}
+	other := valueCount{value: distributionOther}
+	for _, v := range values[limit:] {
+		other.count += v.count
+	}
+	return append(values[:limit], other)
+}
+
+// ShowValueDistribution lists the most frequent values of column col of
+// dataItem with their count and share of the loaded rows.
+func (t *DataBrowser) ShowValueDistribution(dataItem *Data, col int) {
+	t.mu.Lock()
+	values := valueDistribution(dataItem, col, distributionLimit)
+	total := len(dataItem.data)
+	name := dataItem.header[col]
+	t.mu.Unlock()
+	list := widget.NewList(func() int {
+		return len(values)
+	}, func() fyne.CanvasObject {
+		value := widget.NewLabel("template")
+		value.Truncation = fyne.TextTruncateEllipsis
+		return container.NewGridWithColumns(3, value, widget.NewLabel("0"), widget.NewProgressBar())
+	}, func(id widget.ListItemID, co fyne.CanvasObject) {
+		cells := co.(*fyne.Container).Objects
+		cells[0].(*widget.Label).SetText(values[id].value)
+		cells[1].(*widget.Label).SetText(strconv.Itoa(values[id].count))
+		cells[2].(*widget.ProgressBar).SetValue(float64(values[id].count) / float64(total))
+	})
+	d := dialog.NewCustom(fmt.Sprintf("Values of %s in %d loaded rows", name, total), "Close", list, t.w)
+	d.Resize(fyne.NewSize(500, 400))
+	d.Show()
+}
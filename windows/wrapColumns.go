@@ -0,0 +1,43 @@
+package windows
+
+import "fyne.io/fyne/v2"
+
+// wrappedColumns returns the columns whose values wrap, in order: all of
+// them when all is set, otherwise those wrapped from their header menu.
+func (d *Data) wrappedColumns(all bool) []int {
+	var cols []int
+	for col := range d.header {
+		if all || d.wrapColumns[col] {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+// wrapMenuItem switches wrapping of the values of one column on or off.
+// While the global wrap setting is on every column wraps, so the item is
+// shown checked and disabled.
+func (t *DataBrowser) wrapMenuItem(dataItem *Data, col int) *fyne.MenuItem {
+	on := dataItem.wrapColumns[col]
+	item := fyne.NewMenuItem("Wrap Text", func() {
+		t.mu.Lock()
+		if on {
+			delete(dataItem.wrapColumns, col)
+		} else {
+			if dataItem.wrapColumns == nil {
+				dataItem.wrapColumns = make(map[int]bool)
+			}
+			dataItem.wrapColumns[col] = true
+		}
+		t.mu.Unlock()
+		for table, d := range t.tables {
+			if d == dataItem {
+				t.updateRowHeights(table, dataItem)
+				table.Refresh()
+			}
+		}
+	})
+	item.Checked = on || t.wrapText
+	item.Disabled = t.wrapText
+	return item
+}
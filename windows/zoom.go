@@ -0,0 +1,75 @@
+package windows
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+const (
+	// zoomPreferencePrefix is followed by the source of a table to store
+	// its zoom level.
+	zoomPreferencePrefix = "zoom:"
+	zoomStep             = 0.25
+	minZoom              = 0.5
+	maxZoom              = 3
+)
+
+// zoomTheme scales all sizes of the app theme, so a single table can be
+// shown larger than the rest of the window.
+type zoomTheme struct {
+	fyne.Theme
+	scale float32
+}
+
+func (z zoomTheme) Size(name fyne.ThemeSizeName) float32 {
+	return z.Theme.Size(name) * z.scale
+}
+
+// zoomOf returns the zoom level of table, 1 unless changed.
+func (t *DataBrowser) zoomOf(table *widget.Table) float32 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if z, ok := t.zoom[table]; ok {
+		return z
+	}
+	return 1
+}
+
+// newZoomControls wraps table in a theme override and returns it with -/+
+// buttons that change the zoom of this table only. The level is remembered
+// per table source.
+func (t *DataBrowser) newZoomControls(table *widget.Table, dataItem *Data) (fyne.CanvasObject, fyne.CanvasObject) {
+	prefs := fyne.CurrentApp().Preferences()
+	key := zoomPreferencePrefix + dataItem.source
+	override := container.NewThemeOverride(table, theme.Current())
+	level := widget.NewLabel("")
+
+	set := func(z float32) {
+		if z < minZoom || z > maxZoom {
+			return
+		}
+		t.mu.Lock()
+		t.zoom[table] = z
+		t.mu.Unlock()
+		level.SetText(fmt.Sprintf("%.0f%%", z*100))
+		override.Theme = zoomTheme{Theme: theme.Current(), scale: z}
+		override.Refresh()
+		t.updateRowHeights(table, dataItem)
+		t.updateHeaderHeight(table)
+		t.updateColumnWidths(table, dataItem)
+		if dataItem.source != "" {
+			prefs.SetFloat(key, float64(z))
+		}
+	}
+	set(float32(prefs.FloatWithFallback(key, 1)))
+
+	controls := container.NewHBox(
+		widget.NewButtonWithIcon("", theme.ZoomOutIcon(), func() { set(t.zoomOf(table) - zoomStep) }),
+		level,
+		widget.NewButtonWithIcon("", theme.ZoomInIcon(), func() { set(t.zoomOf(table) + zoomStep) }))
+	return override, controls
+}